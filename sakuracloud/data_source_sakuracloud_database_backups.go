@@ -0,0 +1,123 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func dataSourceSakuraCloudDatabaseBackups() *schema.Resource {
+	resourceName := "Database"
+
+	return &schema.Resource{
+		ReadContext: dataSourceSakuraCloudDatabaseBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+				Description:      "The id of the database appliance",
+			},
+			"backups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of backup histories, sorted from newest to oldest",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The id of the backup. This can be specified as `backup_id` of `restore_from` in `sakuracloud_database`",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date on which the backup was taken, in RFC3339 format",
+						},
+						"recovered_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date on which the backup was last restored, in RFC3339 format",
+						},
+						"availability": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The availability of the backup",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the backup in bytes",
+						},
+					},
+				},
+			},
+			"zone": schemaDataSourceZone(resourceName),
+		},
+	}
+}
+
+func dataSourceSakuraCloudDatabaseBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	databaseID := expandSakuraCloudID(d, "database_id")
+	status, err := iaas.NewDatabaseOp(client).Status(ctx, zone, databaseID)
+	if err != nil {
+		return diag.Errorf("could not read status of SakuraCloud Database[%s]: %s", databaseID, err)
+	}
+
+	d.SetId(databaseID.String())
+	d.Set("database_id", databaseID.String()) //nolint
+	d.Set("zone", zone)                       //nolint
+	return diag.FromErr(d.Set("backups", flattenDatabaseBackupHistories(status.Backups)))
+}
+
+func flattenDatabaseBackupHistories(histories []*iaas.DatabaseBackupHistory) []interface{} {
+	sorted := append([]*iaas.DatabaseBackupHistory{}, histories...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+	})
+
+	var results []interface{}
+	for _, h := range sorted {
+		results = append(results, flattenDatabaseBackupHistory(h))
+	}
+	return results
+}
+
+func flattenDatabaseBackupHistory(history *iaas.DatabaseBackupHistory) map[string]interface{} {
+	recoveredAt := ""
+	if !history.RecoveredAt.IsZero() {
+		recoveredAt = history.RecoveredAt.Format(time.RFC3339)
+	}
+	return map[string]interface{}{
+		"id":           databaseBackupID(history),
+		"created_at":   history.CreatedAt.Format(time.RFC3339),
+		"recovered_at": recoveredAt,
+		"availability": history.Availability,
+		"size":         int(history.Size),
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/terraform-provider-sakuracloud/internal/desc"
+)
+
+func dataSourceSakuraCloudDatabaseParameters() *schema.Resource {
+	resourceName := "Database"
+
+	return &schema.Resource{
+		ReadContext: dataSourceSakuraCloudDatabaseParametersRead,
+
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+				ExactlyOneOf:     []string{"database_id", "database_type"},
+				Description:      "The id of the database appliance to read the parameters from",
+			},
+			"database_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.RDBMSTypeStrings, false)),
+				ExactlyOneOf:     []string{"database_id", "database_type"},
+				Description: desc.Sprintf(
+					"The type of the database. The parameters are read from an existing database appliance of this type in the zone. This must be one of [%s]",
+					types.RDBMSTypeStrings,
+				),
+			},
+			"database_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"database_type"},
+				Description:  "The version of the database. This is used with `database_type`",
+			},
+			"parameters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of parameters that can be set to `parameters` of `sakuracloud_database`",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the parameter. This is used as a key of `parameters`",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the parameter value",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the parameter",
+						},
+						"example": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The example of the parameter value",
+						},
+						"min": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The minimum value of the parameter. This is set only when `type` is `number`",
+						},
+						"max": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The maximum value of the parameter. This is set only when `type` is `number`",
+						},
+						"max_length": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The maximum length of the parameter value",
+						},
+						"restart_required": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "The flag indicating that changing the parameter requires restart of the database",
+						},
+						"current_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current value of the parameter on the database appliance that the parameters were read from. This will be empty if the default value is used",
+						},
+					},
+				},
+			},
+			"zone": schemaDataSourceZone(resourceName),
+		},
+	}
+}
+
+func dataSourceSakuraCloudDatabaseParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	databaseID := expandSakuraCloudID(d, "database_id")
+	databaseType := d.Get("database_type").(string)
+	databaseVersion := d.Get("database_version").(string)
+
+	catalog, err := readDatabaseParameterCatalog(ctx, client, zone, databaseID, databaseType, databaseVersion)
+	if err != nil {
+		return diag.Errorf("could not read parameters of SakuraCloud Database: %s", err)
+	}
+	id := databaseID.String()
+	if databaseID.IsEmpty() {
+		id = fmt.Sprintf("%s/%s", databaseType, databaseVersion)
+	}
+	d.SetId(id)
+	d.Set("zone", zone) //nolint
+	// 同じ種別のアプライアンスが存在しない場合はパラメータ定義が取得できないため空とする
+	if catalog == nil {
+		return diag.FromErr(d.Set("parameters", []interface{}{}))
+	}
+	return diag.FromErr(d.Set("parameters", flattenDatabaseParameterCatalog(catalog)))
+}
+
+func flattenDatabaseParameterCatalog(catalog *databaseParameterCatalog) []interface{} {
+	var results []interface{}
+	for _, m := range catalog.metas {
+		results = append(results, map[string]interface{}{
+			"name":             m.Label,
+			"type":             m.Type,
+			"description":      m.Text,
+			"example":          m.Example,
+			"min":              m.Min,
+			"max":              m.Max,
+			"max_length":       m.MaxLen,
+			"restart_required": isDatabaseParameterRestartRequired(m),
+			"current_value":    catalog.currentValue(m),
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].(map[string]interface{})["name"].(string) < results[j].(map[string]interface{})["name"].(string)
+	})
+	return results
+}
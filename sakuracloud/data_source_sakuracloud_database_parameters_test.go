@@ -0,0 +1,83 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSakuraCloudDataSourceDatabaseParameters_basic(t *testing.T) {
+	skipIfFakeModeEnabled(t)
+
+	resourceName := "data.sakuracloud_database_parameters.foobar"
+	rand := randomName()
+	password := randomPassword()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraCloudDatabaseDestroy,
+			testCheckSakuraCloudSwitchDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDataSourceDatabaseParameters_basic, rand, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "sakuracloud_database.foobar", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						"name":          "max_connections",
+						"type":          "number",
+						"current_value": "100",
+					}),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudDataSourceDatabaseParameters_basic = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_database" "foobar" {
+  database_type = "mariadb"
+  plan          = "10g"
+
+  username = "defuser"
+  password = "{{ .arg1 }}"
+
+  network_interface {
+    switch_id  = sakuracloud_switch.foobar.id
+    ip_address = "192.168.110.101"
+    netmask    = 24
+    gateway    = "192.168.110.1"
+  }
+
+  parameters = {
+    max_connections = 100
+  }
+
+  name = "{{ .arg0 }}"
+}
+
+data "sakuracloud_database_parameters" "foobar" {
+  database_id = sakuracloud_database.foobar.id
+}
+`
@@ -49,6 +49,16 @@ func dataSourceSakuraCloudKMS() *schema.Resource {
 				Computed:    true,
 				Description: "Key origin of the KMS key. 'generated' or 'imported'",
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: desc.Sprintf("The status of the %s", resourceName),
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: desc.Sprintf("The latest version of the %s", resourceName),
+			},
 		},
 	}
 }
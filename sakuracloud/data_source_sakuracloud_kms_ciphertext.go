@@ -0,0 +1,129 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/kms-api-go"
+	v1 "github.com/sacloud/kms-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakuracloud/internal/desc"
+)
+
+var (
+	_ datasource.DataSource              = (*dataSourceKMSCiphertext)(nil)
+	_ datasource.DataSourceWithConfigure = (*dataSourceKMSCiphertext)(nil)
+)
+
+// dataSourceKMSCiphertext KMSのキーで平文を暗号化するデータソース
+type dataSourceKMSCiphertext struct {
+	client *APIClient
+}
+
+type dataSourceKMSCiphertextModel struct {
+	ID         types.String `tfsdk:"id"`
+	KMSKeyID   types.String `tfsdk:"kms_key_id"`
+	Plaintext  types.String `tfsdk:"plaintext"`
+	Algorithm  types.String `tfsdk:"algorithm"`
+	Ciphertext types.String `tfsdk:"ciphertext"`
+}
+
+func newDataSourceKMSCiphertext() datasource.DataSource {
+	return &dataSourceKMSCiphertext{}
+}
+
+func (d *dataSourceKMSCiphertext) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_ciphertext"
+}
+
+func (d *dataSourceKMSCiphertext) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the KMS key used for encryption. This is same as `kms_key_id`",
+			},
+			"kms_key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the KMS key used for encryption",
+			},
+			"plaintext": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The plaintext to encrypt",
+			},
+			"algorithm": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(kmsEncryptAlgorithms()...),
+				},
+				Description: desc.Sprintf(
+					"The encryption algorithm. This must be one of [%s]. Default:`%s`",
+					kmsEncryptAlgorithms(), v1.KeyEncryptAlgoEnumAes256Gcm,
+				),
+			},
+			"ciphertext": schema.StringAttribute{
+				Computed:    true,
+				Description: "The encrypted result of `plaintext`. A different value is returned on each read",
+			},
+		},
+	}
+}
+
+func (d *dataSourceKMSCiphertext) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = frameworkAPIClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (d *dataSourceKMSCiphertext) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataSourceKMSCiphertextModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The provider must be configured before reading sakuracloud_kms_ciphertext")
+		return
+	}
+
+	algo := v1.KeyEncryptAlgoEnumAes256Gcm
+	if !model.Algorithm.IsNull() {
+		algo = v1.KeyEncryptAlgoEnum(model.Algorithm.ValueString())
+	}
+
+	keyID := model.KMSKeyID.ValueString()
+	cipher, err := kms.NewKeyOp(d.client.kmsClient).Encrypt(ctx, keyID, []byte(model.Plaintext.ValueString()), algo)
+	if err != nil {
+		resp.Diagnostics.AddError("Encrypt failed", fmt.Sprintf("could not encrypt with KMS[%s] key: %s", keyID, err))
+		return
+	}
+
+	model.ID = types.StringValue(keyID)
+	model.Ciphertext = types.StringValue(cipher)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func kmsEncryptAlgorithms() []string {
+	var algorithms []string
+	for _, v := range v1.KeyEncryptAlgoEnum("").AllValues() {
+		algorithms = append(algorithms, string(v))
+	}
+	return algorithms
+}
@@ -0,0 +1,70 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	v1 "github.com/sacloud/kms-api-go/apis/v1"
+)
+
+func TestAccSakuraCloudDataSourceKMSCiphertext_basic(t *testing.T) {
+	skipIfFakeModeEnabled(t)
+
+	cipherResourceName := "data.sakuracloud_kms_ciphertext.foobar"
+	plainResourceName := "data.sakuracloud_kms_plaintext.foobar"
+	rand := randomName()
+
+	var key v1.Key
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDataSourceKMSCiphertext_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudKMSExists("sakuracloud_kms.foobar", &key),
+					testCheckSakuraCloudDataSourceExists(cipherResourceName),
+					resource.TestCheckResourceAttrSet(cipherResourceName, "ciphertext"),
+					resource.TestCheckResourceAttrPair(cipherResourceName, "kms_key_id", "sakuracloud_kms.foobar", "id"),
+					testCheckSakuraCloudDataSourceExists(plainResourceName),
+					resource.TestCheckResourceAttr(plainResourceName, "plaintext", "plain-text"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudDataSourceKMSCiphertext_basic = `
+resource "sakuracloud_kms" "foobar" {
+  name        = "{{ .arg0 }}"
+  description = "description"
+}
+
+data "sakuracloud_kms_ciphertext" "foobar" {
+  kms_key_id = sakuracloud_kms.foobar.id
+  plaintext  = "plain-text"
+}
+
+data "sakuracloud_kms_plaintext" "foobar" {
+  kms_key_id = sakuracloud_kms.foobar.id
+  ciphertext = data.sakuracloud_kms_ciphertext.foobar.ciphertext
+}
+
+ephemeral "sakuracloud_kms_plaintext" "foobar" {
+  kms_key_id = sakuracloud_kms.foobar.id
+  ciphertext = data.sakuracloud_kms_ciphertext.foobar.ciphertext
+}`
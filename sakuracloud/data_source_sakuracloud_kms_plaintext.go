@@ -0,0 +1,114 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/kms-api-go"
+)
+
+var (
+	_ datasource.DataSource              = (*dataSourceKMSPlaintext)(nil)
+	_ datasource.DataSourceWithConfigure = (*dataSourceKMSPlaintext)(nil)
+)
+
+// dataSourceKMSPlaintext KMSのキーで暗号文を復号するデータソース
+//
+// 復号結果はstateに保存される。stateに保存したくない場合はエフェメラルリソースを利用する。
+type dataSourceKMSPlaintext struct {
+	client *APIClient
+}
+
+type kmsPlaintextModel struct {
+	ID         types.String `tfsdk:"id"`
+	KMSKeyID   types.String `tfsdk:"kms_key_id"`
+	Ciphertext types.String `tfsdk:"ciphertext"`
+	Plaintext  types.String `tfsdk:"plaintext"`
+}
+
+func newDataSourceKMSPlaintext() datasource.DataSource {
+	return &dataSourceKMSPlaintext{}
+}
+
+func (d *dataSourceKMSPlaintext) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_plaintext"
+}
+
+func (d *dataSourceKMSPlaintext) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the KMS key used for decryption. This is same as `kms_key_id`",
+			},
+			"kms_key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the KMS key used for decryption",
+			},
+			"ciphertext": schema.StringAttribute{
+				Required:    true,
+				Description: "The ciphertext encrypted by the KMS key",
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The decrypted result of `ciphertext`",
+			},
+		},
+	}
+}
+
+func (d *dataSourceKMSPlaintext) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = frameworkAPIClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (d *dataSourceKMSPlaintext) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model kmsPlaintextModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if d.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The provider must be configured before reading sakuracloud_kms_plaintext")
+		return
+	}
+
+	resp.Diagnostics.Append(decryptKMSPlaintext(ctx, d.client, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func decryptKMSPlaintext(ctx context.Context, client *APIClient, model *kmsPlaintextModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	keyID := model.KMSKeyID.ValueString()
+	plain, err := kms.NewKeyOp(client.kmsClient).Decrypt(ctx, keyID, model.Ciphertext.ValueString())
+	if err != nil {
+		diags.AddError("Decrypt failed", fmt.Sprintf("could not decrypt with KMS[%s] key: %s", keyID, err))
+		return diags
+	}
+
+	model.ID = types.StringValue(keyID)
+	model.Plaintext = types.StringValue(string(plain))
+	return diags
+}
@@ -0,0 +1,156 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func dataSourceSakuraCloudProxyLBStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSakuraCloudProxyLBStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"proxylb_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+				Description:      "The id of the ProxyLB",
+			},
+			"active_conn": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of active connections",
+			},
+			"cps": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The number of connections per second",
+			},
+			"current_vip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The virtual IP address currently in use",
+			},
+			"server": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the destination server",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The port number of the destination server",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health status of the destination server. This will be `up` when the server is healthy",
+						},
+						"active_conn": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of active connections to the server",
+						},
+						"cps": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The number of connections per second to the server",
+						},
+					},
+				},
+			},
+			"certificate": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     schemaDataSourceProxyLBCertificateStatus(),
+			},
+			"additional_certificate": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     schemaDataSourceProxyLBCertificateStatus(),
+			},
+		},
+	}
+}
+
+func schemaDataSourceProxyLBCertificateStatus() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The common name of the certificate",
+			},
+			"subject_alt_names": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject alternative names of the certificate",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date on which the certificate validity period ends, in RFC3339 format",
+			},
+			"days_until_expiry": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of days until the certificate expires, as of reading the data source",
+			},
+		},
+	}
+}
+
+func dataSourceSakuraCloudProxyLBStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	proxyLBOp := iaas.NewProxyLBOp(client)
+	proxyLBID := expandSakuraCloudID(d, "proxylb_id")
+
+	health, err := proxyLBOp.HealthStatus(ctx, proxyLBID)
+	if err != nil {
+		return diag.Errorf("could not read health status of SakuraCloud ProxyLB[%s]: %s", proxyLBID, err)
+	}
+	certs, err := proxyLBOp.GetCertificates(ctx, proxyLBID)
+	if err != nil {
+		return diag.Errorf("could not read certificates of SakuraCloud ProxyLB[%s]: %s", proxyLBID, err)
+	}
+
+	d.SetId(proxyLBID.String())
+	d.Set("proxylb_id", proxyLBID.String()) //nolint
+	d.Set("active_conn", health.ActiveConn) //nolint
+	d.Set("cps", health.CPS)                //nolint
+	d.Set("current_vip", health.CurrentVIP) //nolint
+	if err := d.Set("server", flattenProxyLBServerStatuses(health)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("certificate", flattenProxyLBPrimaryCertStatus(certs)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set("additional_certificate", flattenProxyLBAdditionalCertStatuses(certs)))
+}
@@ -0,0 +1,80 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSakuraCloudDataSourceProxyLBStatus_basic(t *testing.T) {
+	skipIfEnvIsNotSet(t, envProxyLBRealServerIP0)
+
+	resourceName := "data.sakuracloud_proxylb_status.foobar"
+	rand := randomName()
+	ip0 := os.Getenv(envProxyLBRealServerIP0)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDataSourceProxyLBStatus_basic, rand, ip0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						resourceName, "proxylb_id",
+						"sakuracloud_proxylb.foobar", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "current_vip",
+						"sakuracloud_proxylb.foobar", "vip",
+					),
+					resource.TestCheckResourceAttr(resourceName, "server.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "server.0.ip_address", ip0),
+					resource.TestCheckResourceAttr(resourceName, "server.0.port", "80"),
+					resource.TestCheckResourceAttrSet(resourceName, "server.0.status"),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudDataSourceProxyLBStatus_basic = `
+resource "sakuracloud_proxylb" "foobar" {
+  name = "{{ .arg0 }}"
+
+  health_check {
+    protocol   = "tcp"
+    delay_loop = 10
+  }
+
+  bind_port {
+    proxy_mode = "http"
+    port       = 80
+  }
+
+  server {
+    ip_address = "{{ .arg1 }}"
+    port       = 80
+  }
+}
+
+data "sakuracloud_proxylb_status" "foobar" {
+  proxylb_id = sakuracloud_proxylb.foobar.id
+}
+`
@@ -147,6 +147,11 @@ func dataSourceSakuraCloudServer() *schema.Resource {
 				Computed:    true,
 				Description: "The hostname of the Server",
 			},
+			"power_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current power state of the Server",
+			},
 			"dns_servers": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -0,0 +1,227 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func dataSourceSakuraCloudVPCRouterStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSakuraCloudVPCRouterStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_router_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+				Description:      "The id of the VPC Router",
+			},
+			"session_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of sessions on the VPC Router",
+			},
+			"percentage_of_memory_free": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+				Description: "A list of the percentage of free memory",
+			},
+			"wire_guard_public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public key of the WireGuard server",
+			},
+			"site_to_site_vpn_peer": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"peer": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the opposing appliance",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the site-to-site VPN. This will be `UP` when the tunnel is established",
+						},
+					},
+				},
+			},
+			"dhcp_server_lease": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address leased by the DHCP server",
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The MAC address of the DHCP client",
+						},
+					},
+				},
+			},
+			"l2tp_ipsec_server_session": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     schemaDataSourceVPCRouterRemoteAccessSession(),
+			},
+			"pptp_server_session": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     schemaDataSourceVPCRouterRemoteAccessSession(),
+			},
+			"session_analysis": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_and_destination": schemaDataSourceVPCRouterStatisticsValues("the pair of source and destination"),
+						"destination_address":    schemaDataSourceVPCRouterStatisticsValues("the destination address"),
+						"destination_port":       schemaDataSourceVPCRouterStatisticsValues("the destination port"),
+						"source_address":         schemaDataSourceVPCRouterStatisticsValues("the source address"),
+					},
+				},
+			},
+			"firewall_receive_logs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of the logs of the firewall for receiving packets",
+			},
+			"firewall_send_logs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of the logs of the firewall for sending packets",
+			},
+			"vpn_logs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of the logs of the VPN",
+			},
+			"zone": schemaDataSourceZone("VPC Router"),
+		},
+	}
+}
+
+func schemaDataSourceVPCRouterRemoteAccessSession() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user name of the session",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address assigned to the client",
+			},
+			"time_sec": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The elapsed time of the session in seconds",
+			},
+		},
+	}
+}
+
+func schemaDataSourceVPCRouterStatisticsValues(target string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The value of " + target,
+				},
+				"count": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The number of sessions",
+				},
+			},
+		},
+		Description: "The number of sessions grouped by " + target,
+	}
+}
+
+func dataSourceSakuraCloudVPCRouterStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpcRouterID := expandSakuraCloudID(d, "vpc_router_id")
+	status, err := iaas.NewVPCRouterOp(client).Status(ctx, zone, vpcRouterID)
+	if err != nil {
+		return diag.Errorf("could not read status of SakuraCloud VPCRouter[%s]: %s", vpcRouterID, err)
+	}
+	// Note: 起動直後は/:id/Statusが空となる場合がある
+	if status == nil {
+		status = &iaas.VPCRouterStatus{}
+	}
+
+	d.SetId(vpcRouterID.String())
+	d.Set("vpc_router_id", vpcRouterID.String()) //nolint
+	d.Set("session_count", status.SessionCount)  //nolint
+	if err := d.Set("percentage_of_memory_free", flattenVPCRouterStatusMemoryFree(status)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("wire_guard_public_key", flattenVPCRouterStatusWireGuardPublicKey(status)) //nolint
+	if err := d.Set("site_to_site_vpn_peer", flattenVPCRouterStatusSiteToSiteVPNPeers(status)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("dhcp_server_lease", flattenVPCRouterStatusDHCPServerLeases(status)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("l2tp_ipsec_server_session", flattenVPCRouterStatusL2TPIPsecServerSessions(status)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("pptp_server_session", flattenVPCRouterStatusPPTPServerSessions(status)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("session_analysis", flattenVPCRouterStatusSessionAnalysis(status)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("firewall_receive_logs", status.FirewallReceiveLogs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("firewall_send_logs", status.FirewallSendLogs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("vpn_logs", status.VPNLogs); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("zone", zone) //nolint
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSakuraCloudDataSourceVPCRouterStatus_basic(t *testing.T) {
+	resourceName := "data.sakuracloud_vpc_router_status.foobar"
+	rand := randomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDataSourceVPCRouterStatus_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						resourceName, "vpc_router_id",
+						"sakuracloud_vpc_router.foobar", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "wire_guard_public_key",
+						"sakuracloud_vpc_router.foobar", "wire_guard.0.public_key",
+					),
+					resource.TestCheckResourceAttrSet(resourceName, "session_count"),
+					resource.TestCheckResourceAttr(resourceName, "site_to_site_vpn_peer.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "site_to_site_vpn_peer.0.peer", "10.0.0.1"),
+					resource.TestCheckResourceAttrSet(resourceName, "site_to_site_vpn_peer.0.status"),
+					resource.TestCheckResourceAttrSet(resourceName, "zone"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudDataSourceVPCRouterStatus_basic = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+
+  private_network_interface {
+    index        = 1
+    switch_id    = sakuracloud_switch.foobar.id
+    ip_addresses = ["192.168.11.1"]
+    netmask      = 24
+  }
+
+  dhcp_server {
+    interface_index = 1
+    range_start     = "192.168.11.11"
+    range_stop      = "192.168.11.20"
+  }
+
+  wire_guard {
+    ip_address = "192.168.31.1/24"
+    peer {
+      name         = "example"
+      ip_address   = "192.168.31.11"
+      generate_key = true
+    }
+  }
+
+  site_to_site_vpn {
+    peer              = "10.0.0.1"
+    remote_id         = "10.0.0.1"
+    pre_shared_secret = "example"
+    routes            = ["10.0.0.0/8"]
+    local_prefix      = ["192.168.11.0/24"]
+  }
+}
+
+data "sakuracloud_vpc_router_status" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+}
+`
@@ -0,0 +1,102 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakuracloud/internal/desc"
+)
+
+func dataSourceSakuraCloudVPCRouterVPNPeerConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSakuraCloudVPCRouterVPNPeerConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_router_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+				Description:      "The id of the VPC Router",
+			},
+			"peer": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIPv4Address(),
+				Description:      "The IP address of the opposing appliance. This must be one of the `peer` of `site_to_site_vpn` on the VPC Router",
+			},
+			"format": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(vpcRouterVPNPeerConfigFormats, false)),
+				Description: desc.Sprintf(
+					"The format of the configuration to render. This must be one of [%s]",
+					vpcRouterVPNPeerConfigFormats,
+				),
+			},
+			"pre_shared_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The pre shared secret to embed into the configuration. If omitted, a placeholder is rendered instead",
+			},
+			"public_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public ip address of the VPC Router",
+			},
+			"config": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The rendered configuration for the opposing appliance",
+			},
+			"zone": schemaDataSourceZone("VPC Router"),
+		},
+	}
+}
+
+func dataSourceSakuraCloudVPCRouterVPNPeerConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpcRouterID := expandSakuraCloudID(d, "vpc_router_id")
+	vpcRouter, err := iaas.NewVPCRouterOp(client).Read(ctx, zone, vpcRouterID)
+	if err != nil {
+		return diag.Errorf("could not read SakuraCloud VPCRouter[%s]: %s", vpcRouterID, err)
+	}
+
+	peerConfig, err := expandVPCRouterVPNPeerConfig(vpcRouter, d.Get("peer").(string), d.Get("pre_shared_secret").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	config, err := renderVPCRouterVPNPeerConfig(d.Get("format").(string), peerConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(vpcRouter.ID.String())
+	d.Set("vpc_router_id", vpcRouter.ID.String()) //nolint
+	d.Set("public_ip", peerConfig.RemoteAddress)  //nolint
+	d.Set("config", config)                       //nolint
+	d.Set("zone", zone)                           //nolint
+	return nil
+}
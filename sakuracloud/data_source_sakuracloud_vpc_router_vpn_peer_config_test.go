@@ -0,0 +1,89 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSakuraCloudDataSourceVPCRouterVPNPeerConfig_basic(t *testing.T) {
+	resourceName := "data.sakuracloud_vpc_router_vpn_peer_config.foobar"
+	rand := randomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDataSourceVPCRouterVPNPeerConfig_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						resourceName, "vpc_router_id",
+						"sakuracloud_vpc_router.foobar", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "public_ip",
+						"sakuracloud_vpc_router.foobar", "public_ip",
+					),
+					resource.TestMatchResourceAttr(resourceName, "config", regexp.MustCompile(`proposals = aes256-sha256-modp2048`)),
+					resource.TestMatchResourceAttr(resourceName, "config", regexp.MustCompile(`local_ts = 10.0.0.0/8`)),
+					resource.TestMatchResourceAttr(resourceName, "config", regexp.MustCompile(`remote_ts = 192.168.11.0/24`)),
+					resource.TestMatchResourceAttr(resourceName, "config", regexp.MustCompile(`secret = "example"`)),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudDataSourceVPCRouterVPNPeerConfig_basic = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+
+  private_network_interface {
+    index        = 1
+    switch_id    = sakuracloud_switch.foobar.id
+    ip_addresses = ["192.168.11.1"]
+    netmask      = 24
+  }
+
+  site_to_site_vpn {
+    peer              = "10.0.0.1"
+    remote_id         = "10.0.0.1"
+    pre_shared_secret = "example"
+    routes            = ["10.0.0.0/8"]
+    local_prefix      = ["192.168.11.0/24"]
+  }
+
+  site_to_site_vpn_parameter {
+    encryption_algo = "aes256"
+    hash_algo       = "sha256"
+    dh_group        = "modp2048"
+  }
+}
+
+data "sakuracloud_vpc_router_vpn_peer_config" "foobar" {
+  vpc_router_id     = sakuracloud_vpc_router.foobar.id
+  peer              = "10.0.0.1"
+  format            = "strongswan"
+  pre_shared_secret = "example"
+}
+`
@@ -0,0 +1,169 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+)
+
+const databaseBackupPollingInterval = 10 * time.Second
+
+// databaseBackupOp iaas-api-goが未対応のデータベースアプライアンスのバックアップ操作を行う
+//
+// エンドポイントとリクエストボディはlibsacloud v1(api/database.go)のDatabaseAPIのBackup/Restore/DeleteBackup/HistoryLock/HistoryUnlockに準じる
+type databaseBackupOp struct {
+	caller iaas.APICaller
+}
+
+func newDatabaseBackupOp(caller iaas.APICaller) *databaseBackupOp {
+	return &databaseBackupOp{caller: caller}
+}
+
+func (o *databaseBackupOp) url(zone string, id types.ID, action string, backupID string) string {
+	u := fmt.Sprintf("%s/%s/api/cloud/1.1/appliance/%s/action/%s", iaas.SakuraCloudAPIRoot, zone, id, action)
+	if backupID != "" {
+		u += "/" + backupID
+	}
+	return u
+}
+
+// Create オンデマンドバックアップを取得する
+func (o *databaseBackupOp) Create(ctx context.Context, zone string, id types.ID) error {
+	body := map[string]interface{}{
+		"Appliance": map[string]interface{}{
+			"Settings": map[string]interface{}{
+				"DBConf": map[string]interface{}{
+					"backup": map[string]string{
+						"availability": "discontinued",
+					},
+				},
+			},
+		},
+	}
+	_, err := o.caller.Do(ctx, "POST", o.url(zone, id, "history", ""), body)
+	return err
+}
+
+// Delete バックアップを削除する
+func (o *databaseBackupOp) Delete(ctx context.Context, zone string, id types.ID, backupID string) error {
+	_, err := o.caller.Do(ctx, "DELETE", o.url(zone, id, "history", backupID), databaseBackupEmptyBody())
+	return err
+}
+
+// Lock バックアップをローテーションによる削除対象から除外する
+func (o *databaseBackupOp) Lock(ctx context.Context, zone string, id types.ID, backupID string) error {
+	_, err := o.caller.Do(ctx, "PUT", o.url(zone, id, "history-lock", backupID), databaseBackupEmptyBody())
+	return err
+}
+
+// Unlock バックアップのロックを解除する
+func (o *databaseBackupOp) Unlock(ctx context.Context, zone string, id types.ID, backupID string) error {
+	_, err := o.caller.Do(ctx, "DELETE", o.url(zone, id, "history-lock", backupID), databaseBackupEmptyBody())
+	return err
+}
+
+// Restore アプライアンス自身のバックアップからデータを復元する
+func (o *databaseBackupOp) Restore(ctx context.Context, zone string, id types.ID, backupID string) error {
+	_, err := o.caller.Do(ctx, "POST", o.url(zone, id, "history", backupID), databaseBackupEmptyBody())
+	return err
+}
+
+func databaseBackupEmptyBody() map[string]interface{} {
+	return map[string]interface{}{
+		"Appliance": map[string]interface{}{},
+	}
+}
+
+// databaseBackupID バックアップ履歴のIDを返す。APIでは作成日時をIDとして扱う
+func databaseBackupID(history *iaas.DatabaseBackupHistory) string {
+	return history.CreatedAt.Format(time.RFC3339)
+}
+
+func findDatabaseBackupHistory(histories []*iaas.DatabaseBackupHistory, backupID string) *iaas.DatabaseBackupHistory {
+	want, err := time.Parse(time.RFC3339, backupID)
+	if err != nil {
+		return nil
+	}
+	for _, h := range histories {
+		if h.CreatedAt.Equal(want) {
+			return h
+		}
+	}
+	return nil
+}
+
+// waitForDatabaseBackupCreated knownに含まれないバックアップ履歴が利用可能になるまで待ち、そのバックアップを返す
+func waitForDatabaseBackupCreated(ctx context.Context, dbOp iaas.DatabaseAPI, zone string, id types.ID, known []*iaas.DatabaseBackupHistory) (*iaas.DatabaseBackupHistory, error) {
+	for {
+		status, err := dbOp.Status(ctx, zone, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range status.Backups {
+			if findDatabaseBackupHistory(known, databaseBackupID(h)) != nil {
+				continue
+			}
+			if strings.EqualFold(h.Availability, "available") {
+				return h, nil
+			}
+			if strings.EqualFold(h.Availability, "failed") {
+				return nil, fmt.Errorf("backup %s is failed", databaseBackupID(h))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for the backup to be available: %s", ctx.Err())
+		case <-time.After(databaseBackupPollingInterval):
+		}
+	}
+}
+
+// waitForDatabaseUp 復元処理が完了しアプライアンスが起動状態となるまで待つ
+func waitForDatabaseUp(ctx context.Context, dbOp iaas.DatabaseAPI, zone string, id types.ID) error {
+	for {
+		db, err := dbOp.Read(ctx, zone, id)
+		if err != nil {
+			return err
+		}
+		if db.Availability.IsFailed() {
+			return fmt.Errorf("got unexpected state: Database[%s].Availability is failed", id)
+		}
+		if db.Availability.IsAvailable() && db.InstanceStatus.IsUp() {
+			status, err := dbOp.Status(ctx, zone, id)
+			if err != nil {
+				return err
+			}
+			if status.IsFatal {
+				return fmt.Errorf("got unexpected state: Database[%s] is in fatal status", id)
+			}
+			if status.Status.IsUp() {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for Database[%s] to be up: %s", id, ctx.Err())
+		case <-time.After(databaseBackupPollingInterval):
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+type dummyDatabaseBackupCaller struct {
+	method   string
+	uri      string
+	body     interface{}
+	response []byte
+}
+
+func (c *dummyDatabaseBackupCaller) Do(_ context.Context, method, uri string, body interface{}) ([]byte, error) {
+	c.method, c.uri, c.body = method, uri, body
+	return c.response, nil
+}
+
+func TestDatabaseBackupOp(t *testing.T) {
+	ctx := context.Background()
+	caller := &dummyDatabaseBackupCaller{}
+	op := newDatabaseBackupOp(caller)
+	id := types.ID(123456789012)
+	backupID := "2026-10-01T03:04:05+09:00"
+	baseURL := iaas.SakuraCloudAPIRoot + "/is1a/api/cloud/1.1/appliance/123456789012/action"
+	emptyBody := map[string]interface{}{"Appliance": map[string]interface{}{}}
+
+	require.NoError(t, op.Create(ctx, "is1a", id))
+	require.Equal(t, "POST", caller.method)
+	require.Equal(t, baseURL+"/history", caller.uri)
+	require.Equal(t, map[string]string{
+		"availability": "discontinued",
+	}, caller.body.(map[string]interface{})["Appliance"].(map[string]interface{})["Settings"].(map[string]interface{})["DBConf"].(map[string]interface{})["backup"])
+
+	require.NoError(t, op.Lock(ctx, "is1a", id, backupID))
+	require.Equal(t, "PUT", caller.method)
+	require.Equal(t, baseURL+"/history-lock/2026-10-01T03:04:05+09:00", caller.uri)
+	require.Equal(t, emptyBody, caller.body)
+
+	require.NoError(t, op.Unlock(ctx, "is1a", id, backupID))
+	require.Equal(t, "DELETE", caller.method)
+	require.Equal(t, baseURL+"/history-lock/2026-10-01T03:04:05+09:00", caller.uri)
+
+	require.NoError(t, op.Delete(ctx, "is1a", id, backupID))
+	require.Equal(t, "DELETE", caller.method)
+	require.Equal(t, baseURL+"/history/2026-10-01T03:04:05+09:00", caller.uri)
+	require.Equal(t, emptyBody, caller.body)
+
+	require.NoError(t, op.Restore(ctx, "is1a", id, backupID))
+	require.Equal(t, "POST", caller.method)
+	require.Equal(t, baseURL+"/history/2026-10-01T03:04:05+09:00", caller.uri)
+	require.Equal(t, emptyBody, caller.body)
+}
+
+func TestFindDatabaseBackupHistory(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	histories := []*iaas.DatabaseBackupHistory{
+		{CreatedAt: time.Date(2026, 10, 1, 3, 4, 5, 0, jst), Availability: "available"},
+		{CreatedAt: time.Date(2026, 10, 2, 3, 4, 5, 0, jst), Availability: "available"},
+	}
+
+	require.Equal(t, histories[1], findDatabaseBackupHistory(histories, "2026-10-02T03:04:05+09:00"))
+	// タイムゾーンが異なっていても同時刻であれば同じバックアップとみなす
+	require.Equal(t, histories[0], findDatabaseBackupHistory(histories, "2026-09-30T18:04:05Z"))
+	require.Nil(t, findDatabaseBackupHistory(histories, "2026-10-03T03:04:05+09:00"))
+	require.Nil(t, findDatabaseBackupHistory(histories, "invalid"))
+
+	flattened := flattenDatabaseBackupHistories(histories)
+	require.Len(t, flattened, 2)
+	require.Equal(t, "2026-10-02T03:04:05+09:00", flattened[0].(map[string]interface{})["id"])
+	require.Equal(t, "", flattened[0].(map[string]interface{})["recovered_at"])
+}
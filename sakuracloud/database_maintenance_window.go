@@ -0,0 +1,86 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	_ "time/tzdata" // maintenance_window.time_zoneをOSのタイムゾーンデータベースに依存せず解決するため
+
+	"github.com/sacloud/iaas-api-go/types"
+)
+
+// databaseMaintenanceWindow 再起動を伴う更新を許可する時間帯
+type databaseMaintenanceWindow struct {
+	daysOfWeek []time.Weekday // 空の場合は毎日
+	startTime  time.Duration  // 0時からの経過時間
+	duration   time.Duration
+	location   *time.Location
+	force      bool
+}
+
+// contains tがメンテナンスウィンドウ内であるか
+func (w *databaseMaintenanceWindow) contains(t time.Time) bool {
+	t = t.In(w.location)
+	// 日付をまたぐウィンドウを考慮し、前日に開始したウィンドウも対象とする
+	for _, offset := range []int{0, -1} {
+		day := t.AddDate(0, 0, offset)
+		start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, w.location).Add(w.startTime)
+		if len(w.daysOfWeek) > 0 && !slices.Contains(w.daysOfWeek, start.Weekday()) {
+			continue
+		}
+		if !t.Before(start) && t.Before(start.Add(w.duration)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *databaseMaintenanceWindow) String() string {
+	days := "every day"
+	if len(w.daysOfWeek) > 0 {
+		var names []string
+		for _, wd := range w.daysOfWeek {
+			names = append(names, types.DaysOfTheWeekStrings[wd])
+		}
+		days = strings.Join(names, ",")
+	}
+	start := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(w.startTime)
+	return fmt.Sprintf("%s %s for %s (%s)", days, start.Format("15:04"), w.duration, w.location)
+}
+
+// checkDatabaseMaintenanceWindow 再起動を伴う更新がメンテナンスウィンドウ外で行われようとしていないか検証する
+func checkDatabaseMaintenanceWindow(w *databaseMaintenanceWindow, now time.Time, changes []string) error {
+	if w == nil || w.force || len(changes) == 0 || w.contains(now) {
+		return nil
+	}
+	return fmt.Errorf(
+		"changing %s requires restarting the Database and is not allowed outside the maintenance window [%s]. Set maintenance_window.force to true to apply it anyway",
+		strings.Join(changes, ", "), w,
+	)
+}
+
+// checkDatabaseReplacementWindow データベースの再作成がメンテナンスウィンドウ外で行われようとしていないか検証する
+func checkDatabaseReplacementWindow(w *databaseMaintenanceWindow, now time.Time, changes []string) error {
+	if w == nil || w.force || len(changes) == 0 || w.contains(now) {
+		return nil
+	}
+	return fmt.Errorf(
+		"changing %s replaces the Database, destroying its data and backups, and is not allowed outside the maintenance window [%s]. Set maintenance_window.force to true to apply it anyway",
+		strings.Join(changes, ", "), w,
+	)
+}
@@ -0,0 +1,122 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseMaintenanceWindow(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+
+	cases := []struct {
+		name   string
+		window *databaseMaintenanceWindow
+		now    time.Time
+		expect bool
+	}{
+		{
+			name:   "every day: within the window",
+			window: &databaseMaintenanceWindow{startTime: 2 * time.Hour, duration: 2 * time.Hour, location: jst},
+			now:    time.Date(2026, 10, 14, 3, 59, 0, 0, jst),
+			expect: true,
+		},
+		{
+			name:   "every day: end of the window is excluded",
+			window: &databaseMaintenanceWindow{startTime: 2 * time.Hour, duration: 2 * time.Hour, location: jst},
+			now:    time.Date(2026, 10, 14, 4, 0, 0, 0, jst),
+			expect: false,
+		},
+		{
+			name:   "evaluated in the time zone of the window",
+			window: &databaseMaintenanceWindow{startTime: 2 * time.Hour, duration: 2 * time.Hour, location: jst},
+			now:    time.Date(2026, 10, 13, 17, 30, 0, 0, time.UTC), // 2026-10-14 02:30 JST
+			expect: true,
+		},
+		{
+			name: "days of week: other day",
+			window: &databaseMaintenanceWindow{
+				daysOfWeek: []time.Weekday{time.Sunday},
+				startTime:  2 * time.Hour,
+				duration:   2 * time.Hour,
+				location:   jst,
+			},
+			now:    time.Date(2026, 10, 14, 3, 0, 0, 0, jst), // Wednesday
+			expect: false,
+		},
+		{
+			name: "window started on the previous day",
+			window: &databaseMaintenanceWindow{
+				daysOfWeek: []time.Weekday{time.Saturday},
+				startTime:  23 * time.Hour,
+				duration:   3 * time.Hour,
+				location:   jst,
+			},
+			now:    time.Date(2026, 10, 18, 1, 30, 0, 0, jst), // Sunday
+			expect: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expect, tc.window.contains(tc.now))
+		})
+	}
+}
+
+func TestCheckDatabaseMaintenanceWindow(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	window := &databaseMaintenanceWindow{
+		daysOfWeek: []time.Weekday{time.Sunday},
+		startTime:  2 * time.Hour,
+		duration:   2 * time.Hour,
+		location:   jst,
+	}
+	outside := time.Date(2026, 10, 14, 12, 0, 0, 0, jst)
+
+	require.NoError(t, checkDatabaseMaintenanceWindow(nil, outside, []string{"replica_password"}))
+	require.NoError(t, checkDatabaseMaintenanceWindow(window, outside, nil))
+	require.NoError(t, checkDatabaseMaintenanceWindow(window, time.Date(2026, 10, 18, 2, 0, 0, 0, jst), []string{"replica_password"}))
+
+	err := checkDatabaseMaintenanceWindow(window, outside, []string{"replica_password", "parameters.max_connections"})
+	require.EqualError(t, err, "changing replica_password, parameters.max_connections requires restarting the Database and is not allowed outside the maintenance window [sun 02:00 for 2h0m0s (JST)]. Set maintenance_window.force to true to apply it anyway")
+
+	window.force = true
+	require.NoError(t, checkDatabaseMaintenanceWindow(window, outside, []string{"replica_password"}))
+}
+
+func TestCheckDatabaseReplacementWindow(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	window := &databaseMaintenanceWindow{
+		daysOfWeek: []time.Weekday{time.Sunday},
+		startTime:  2 * time.Hour,
+		duration:   2 * time.Hour,
+		location:   jst,
+	}
+	outside := time.Date(2026, 10, 14, 12, 0, 0, 0, jst)
+
+	require.NoError(t, checkDatabaseReplacementWindow(nil, outside, []string{"plan"}))
+	require.NoError(t, checkDatabaseReplacementWindow(window, outside, nil))
+	require.NoError(t, checkDatabaseReplacementWindow(window, time.Date(2026, 10, 18, 3, 0, 0, 0, jst), []string{"plan"}))
+
+	err := checkDatabaseReplacementWindow(window, outside, []string{"plan"})
+	require.EqualError(t, err, "changing plan replaces the Database, destroying its data and backups, and is not allowed outside the maintenance window [sun 02:00 for 2h0m0s (JST)]. Set maintenance_window.force to true to apply it anyway")
+
+	window.force = true
+	require.NoError(t, checkDatabaseReplacementWindow(window, outside, []string{"plan"}))
+}
@@ -0,0 +1,202 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+)
+
+// databaseParameterCatalog データベースアプライアンスで設定可能なパラメータの一覧
+//
+// APIではアプライアンスごとにパラメータの定義(Remark.Form)が返されるため、既存のアプライアンスから取得する
+type databaseParameterCatalog struct {
+	metas    []*iaas.DatabaseParameterMeta
+	settings map[string]interface{}
+}
+
+func newDatabaseParameterCatalog(parameter *iaas.DatabaseParameter) *databaseParameterCatalog {
+	return &databaseParameterCatalog{
+		metas:    parameter.MetaInfo,
+		settings: parameter.Settings,
+	}
+}
+
+// readDatabaseParameterCatalog idのアプライアンスからパラメータ定義を取得する
+//
+// idが空の場合はゾーン内のdatabaseType/databaseVersionが一致するアプライアンスから取得する。
+// 該当するアプライアンスがない、またはパラメータ定義が返されなかった場合はnilを返す
+func readDatabaseParameterCatalog(ctx context.Context, client *APIClient, zone string, id types.ID, databaseType, databaseVersion string) (*databaseParameterCatalog, error) {
+	dbOp := iaas.NewDatabaseOp(client)
+	if id.IsEmpty() {
+		found, err := dbOp.Find(ctx, zone, &iaas.FindCondition{})
+		if err != nil {
+			return nil, err
+		}
+		for _, db := range found.Databases {
+			if flattenDatabaseType(db) != databaseType || (databaseVersion != "" && db.Conf.DatabaseVersion != databaseVersion) {
+				continue
+			}
+			if db.Availability.IsAvailable() {
+				id = db.ID
+				break
+			}
+		}
+		if id.IsEmpty() {
+			return nil, nil
+		}
+	}
+
+	parameter, err := dbOp.GetParameter(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(parameter.MetaInfo) == 0 {
+		return nil, nil
+	}
+	return newDatabaseParameterCatalog(parameter), nil
+}
+
+// find ラベル(例: max_connections)または名前でパラメータ定義を探す
+func (c *databaseParameterCatalog) find(key string) *iaas.DatabaseParameterMeta {
+	for _, m := range c.metas {
+		if m.Label == key || m.Name == key {
+			return m
+		}
+	}
+	return nil
+}
+
+// currentValue パラメータ定義の取得元アプライアンスでの現在値を返す
+func (c *databaseParameterCatalog) currentValue(meta *iaas.DatabaseParameterMeta) string {
+	if v, ok := c.settings[meta.Name]; ok && v != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return ""
+}
+
+// validate パラメータのキー、値の型/範囲/長さを検証する
+func (c *databaseParameterCatalog) validate(parameters map[string]interface{}) error {
+	keys := make([]string, 0, len(parameters))
+	for k := range parameters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var messages []string
+	for _, k := range keys {
+		meta := c.find(k)
+		if meta == nil {
+			msg := fmt.Sprintf("parameters: %q is not a valid parameter", k)
+			if suggestion := c.suggest(k); suggestion != "" {
+				msg += fmt.Sprintf(", did you mean %q?", suggestion)
+			}
+			messages = append(messages, msg)
+			continue
+		}
+		if err := validateDatabaseParameterValue(meta, fmt.Sprintf("%v", parameters[k])); err != nil {
+			messages = append(messages, fmt.Sprintf("parameters: %q %s", k, err))
+		}
+	}
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, "\n"))
+	}
+	return nil
+}
+
+// suggest keyに最も近いパラメータ名を返す。近いものがなければ空文字を返す
+func (c *databaseParameterCatalog) suggest(key string) string {
+	suggestion := ""
+	best := len(key)/3 + 1
+	for _, m := range c.metas {
+		if d := levenshteinDistance(key, m.Label); d < best {
+			best = d
+			suggestion = m.Label
+		}
+	}
+	return suggestion
+}
+
+// restartRequired beforeからafterへの変更のうち、反映に再起動が必要なパラメータのラベルを返す
+func (c *databaseParameterCatalog) restartRequired(before, after map[string]interface{}) []string {
+	var results []string
+	for k, v := range after {
+		if ov, ok := before[k]; ok && fmt.Sprintf("%v", ov) == fmt.Sprintf("%v", v) {
+			continue
+		}
+		if meta := c.find(k); meta != nil && isDatabaseParameterRestartRequired(meta) {
+			results = append(results, meta.Label)
+		}
+	}
+	for k := range before {
+		if _, ok := after[k]; ok {
+			continue
+		}
+		if meta := c.find(k); meta != nil && isDatabaseParameterRestartRequired(meta) {
+			results = append(results, meta.Label)
+		}
+	}
+	sort.Strings(results)
+	return results
+}
+
+func isDatabaseParameterRestartRequired(meta *iaas.DatabaseParameterMeta) bool {
+	// 動的に変更可能なパラメータは"dynamic"、再起動が必要なパラメータは"static"となる
+	return meta.Reboot != "" && meta.Reboot != "dynamic"
+}
+
+func validateDatabaseParameterValue(meta *iaas.DatabaseParameterMeta, value string) error {
+	if meta.Type == "number" {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("must be a number: %q", value)
+		}
+		if (meta.Min != 0 || meta.Max != 0) && (v < meta.Min || meta.Max < v) {
+			return fmt.Errorf("must be in the range [%v-%v]: %s", meta.Min, meta.Max, value)
+		}
+		return nil
+	}
+	if meta.MaxLen > 0 && len(value) > meta.MaxLen {
+		return fmt.Errorf("must be at most %d characters: %q", meta.MaxLen, value)
+	}
+	return nil
+}
+
+func levenshteinDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,97 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/stretchr/testify/require"
+)
+
+func testDatabaseParameterCatalog() *databaseParameterCatalog {
+	return newDatabaseParameterCatalog(&iaas.DatabaseParameter{
+		Settings: map[string]interface{}{
+			"MariaDB/server.cnf/mysqld/max_connections": float64(100),
+		},
+		MetaInfo: []*iaas.DatabaseParameterMeta{
+			{Type: "number", Name: "MariaDB/server.cnf/mysqld/max_connections", Label: "max_connections", Min: 50, Max: 1000, Reboot: "dynamic"},
+			{Type: "string", Name: "MariaDB/server.cnf/mysqld/event_scheduler", Label: "event_scheduler", MaxLen: 3, Reboot: "dynamic"},
+			{Type: "number", Name: "MariaDB/server.cnf/mysqld/innodb_buffer_pool_size", Label: "innodb_buffer_pool_size", Reboot: "static"},
+		},
+	})
+}
+
+func TestDatabaseParameterCatalog_validate(t *testing.T) {
+	catalog := testDatabaseParameterCatalog()
+
+	require.NoError(t, catalog.validate(map[string]interface{}{
+		"max_connections": "100",
+		"event_scheduler": "ON",
+		"MariaDB/server.cnf/mysqld/innodb_buffer_pool_size": "134217728",
+	}))
+
+	err := catalog.validate(map[string]interface{}{
+		"max_conections":  "100",
+		"max_connections": "10",
+		"event_scheduler": "ENABLED",
+		"unknown":         "1",
+	})
+	require.Error(t, err)
+	require.Equal(t, `parameters: "event_scheduler" must be at most 3 characters: "ENABLED"
+parameters: "max_conections" is not a valid parameter, did you mean "max_connections"?
+parameters: "max_connections" must be in the range [50-1000]: 10
+parameters: "unknown" is not a valid parameter`, err.Error())
+
+	require.EqualError(t, catalog.validate(map[string]interface{}{"max_connections": "many"}),
+		`parameters: "max_connections" must be a number: "many"`)
+}
+
+func TestDatabaseParameterCatalog_restartRequired(t *testing.T) {
+	catalog := testDatabaseParameterCatalog()
+
+	require.Empty(t, catalog.restartRequired(
+		map[string]interface{}{"max_connections": "100"},
+		map[string]interface{}{"max_connections": "200"},
+	))
+	require.Equal(t, []string{"innodb_buffer_pool_size"}, catalog.restartRequired(
+		map[string]interface{}{"max_connections": "100"},
+		map[string]interface{}{"max_connections": "200", "innodb_buffer_pool_size": "134217728"},
+	))
+	// 削除された場合もデフォルト値に戻すために再起動が必要
+	require.Equal(t, []string{"innodb_buffer_pool_size"}, catalog.restartRequired(
+		map[string]interface{}{"innodb_buffer_pool_size": "134217728"},
+		map[string]interface{}{},
+	))
+	require.Empty(t, catalog.restartRequired(
+		map[string]interface{}{"innodb_buffer_pool_size": "134217728"},
+		map[string]interface{}{"innodb_buffer_pool_size": "134217728"},
+	))
+}
+
+func TestFlattenDatabaseParameterCatalog(t *testing.T) {
+	flattened := flattenDatabaseParameterCatalog(testDatabaseParameterCatalog())
+	require.Len(t, flattened, 3)
+
+	first := flattened[0].(map[string]interface{})
+	require.Equal(t, "event_scheduler", first["name"])
+	require.Equal(t, "", first["current_value"])
+
+	last := flattened[2].(map[string]interface{})
+	require.Equal(t, "max_connections", last["name"])
+	require.Equal(t, "100", last["current_value"])
+	require.Equal(t, false, last["restart_required"])
+	require.Equal(t, true, flattened[1].(map[string]interface{})["restart_required"])
+}
@@ -0,0 +1,368 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+)
+
+const databaseSQLConnectTimeout = 10 * time.Second
+
+// databaseSQLConnInfo データベースアプライアンスへのSQL接続情報
+type databaseSQLConnInfo struct {
+	databaseType string // mariadb or postgres
+	host         string
+	port         int
+	user         string
+	password     string
+}
+
+// databaseSQLClient データベースアプライアンス上のユーザー/スキーマ/権限をSQLで操作するためのクライアント
+//
+// MariaDBとPostgreSQLで発行するSQLが異なるため、RDBMSごとに実装する
+type databaseSQLClient interface {
+	ReadUser(ctx context.Context, name, host string) (bool, error)
+	CreateUser(ctx context.Context, name, host, password string) error
+	UpdateUserPassword(ctx context.Context, name, host, password string) error
+	DropUser(ctx context.Context, name, host string) error
+
+	// ReadSchema スキーマの存在有無とオーナーを返す(MariaDBの場合オーナーは常に空)
+	ReadSchema(ctx context.Context, name string) (owner string, exists bool, err error)
+	CreateSchema(ctx context.Context, name, owner string) error
+	UpdateSchemaOwner(ctx context.Context, name, owner string) error
+	DropSchema(ctx context.Context, name string) error
+
+	ReadPrivileges(ctx context.Context, user, host, schema string) ([]string, error)
+	Grant(ctx context.Context, user, host, schema string, privileges []string) error
+	Revoke(ctx context.Context, user, host, schema string, privileges []string) error
+
+	Close() error
+}
+
+var (
+	// databaseMariaDBPrivileges MariaDBでスキーマ単位に付与できる権限
+	databaseMariaDBPrivileges = []string{
+		"ALTER", "ALTER ROUTINE", "CREATE", "CREATE ROUTINE", "CREATE TEMPORARY TABLES", "CREATE VIEW",
+		"DELETE", "DROP", "EVENT", "EXECUTE", "INDEX", "INSERT", "LOCK TABLES", "REFERENCES",
+		"SELECT", "SHOW VIEW", "TRIGGER", "UPDATE",
+	}
+	// databasePostgresSchemaPrivileges PostgreSQLでスキーマ自体に付与する権限
+	databasePostgresSchemaPrivileges = []string{"CREATE", "USAGE"}
+	// databasePostgresTablePrivileges PostgreSQLでスキーマ内の全テーブルに付与する権限
+	databasePostgresTablePrivileges = []string{"DELETE", "INSERT", "REFERENCES", "SELECT", "TRIGGER", "TRUNCATE", "UPDATE"}
+)
+
+// databasePrivileges 指定のRDBMSで指定可能な権限の一覧を返す
+func databasePrivileges(databaseType string) []string {
+	switch databaseType {
+	case "mariadb":
+		return databaseMariaDBPrivileges
+	case "postgres":
+		return append(append([]string{}, databasePostgresSchemaPrivileges...), databasePostgresTablePrivileges...)
+	}
+	return nil
+}
+
+func openDatabaseSQLClient(ctx context.Context, info *databaseSQLConnInfo) (databaseSQLClient, error) {
+	var client databaseSQLClient
+	var db *sql.DB
+	var err error
+
+	switch info.databaseType {
+	case "mariadb":
+		db, err = sql.Open("mysql", mariaDBDSN(info))
+		client = &mariaDBSQLClient{db: db}
+	case "postgres":
+		db, err = sql.Open("postgres", postgresDSN(info))
+		client = &postgresSQLClient{db: db}
+	default:
+		return nil, fmt.Errorf("unsupported database type: %q", info.databaseType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, databaseSQLConnectTimeout)
+	defer cancel()
+	if err := db.PingContext(pingCtx); err != nil {
+		db.Close() //nolint:errcheck,gosec
+		return nil, fmt.Errorf("could not connect to %s: %s", net.JoinHostPort(info.host, strconv.Itoa(info.port)), err)
+	}
+	return client, nil
+}
+
+func mariaDBDSN(info *databaseSQLConnInfo) string {
+	conf := mysql.NewConfig()
+	conf.User = info.user
+	conf.Passwd = info.password
+	conf.Net = "tcp"
+	conf.Addr = net.JoinHostPort(info.host, strconv.Itoa(info.port))
+	conf.Timeout = databaseSQLConnectTimeout
+	return conf.FormatDSN()
+}
+
+func postgresDSN(info *databaseSQLConnInfo) string {
+	query := url.Values{}
+	query.Set("sslmode", "disable")
+	query.Set("connect_timeout", strconv.Itoa(int(databaseSQLConnectTimeout.Seconds())))
+	u := &url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(info.user, info.password),
+		Host:   net.JoinHostPort(info.host, strconv.Itoa(info.port)),
+		// アプライアンスではデフォルトユーザーと同名のデータベースが作成される
+		Path:     "/" + info.user,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+func queryDatabaseStrings(ctx context.Context, db *sql.DB, query string, args ...interface{}) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var results []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		results = append(results, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	sort.Strings(results)
+	return results, nil
+}
+
+func execDatabaseStatements(ctx context.Context, db *sql.DB, statements ...string) error {
+	for _, stmt := range statements {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+ * MariaDB
+ */
+
+type mariaDBSQLClient struct {
+	db *sql.DB
+}
+
+func quoteMariaDBIdentifier(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
+
+func quoteMariaDBString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func mariaDBAccount(name, host string) string {
+	return quoteMariaDBString(name) + "@" + quoteMariaDBString(host)
+}
+
+func (c *mariaDBSQLClient) ReadUser(ctx context.Context, name, host string) (bool, error) {
+	var count int
+	err := c.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM mysql.user WHERE User = ? AND Host = ?", name, host).Scan(&count)
+	return count > 0, err
+}
+
+func (c *mariaDBSQLClient) CreateUser(ctx context.Context, name, host, password string) error {
+	return execDatabaseStatements(ctx, c.db,
+		fmt.Sprintf("CREATE USER %s IDENTIFIED BY %s", mariaDBAccount(name, host), quoteMariaDBString(password)))
+}
+
+func (c *mariaDBSQLClient) UpdateUserPassword(ctx context.Context, name, host, password string) error {
+	return execDatabaseStatements(ctx, c.db,
+		fmt.Sprintf("ALTER USER %s IDENTIFIED BY %s", mariaDBAccount(name, host), quoteMariaDBString(password)))
+}
+
+func (c *mariaDBSQLClient) DropUser(ctx context.Context, name, host string) error {
+	return execDatabaseStatements(ctx, c.db, fmt.Sprintf("DROP USER IF EXISTS %s", mariaDBAccount(name, host)))
+}
+
+func (c *mariaDBSQLClient) ReadSchema(ctx context.Context, name string) (string, bool, error) {
+	var count int
+	err := c.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM information_schema.SCHEMATA WHERE SCHEMA_NAME = ?", name).Scan(&count)
+	return "", count > 0, err
+}
+
+func (c *mariaDBSQLClient) CreateSchema(ctx context.Context, name, owner string) error {
+	if owner != "" {
+		return fmt.Errorf("owner is not supported on MariaDB")
+	}
+	return execDatabaseStatements(ctx, c.db, fmt.Sprintf("CREATE SCHEMA %s", quoteMariaDBIdentifier(name)))
+}
+
+func (c *mariaDBSQLClient) UpdateSchemaOwner(ctx context.Context, name, owner string) error {
+	return fmt.Errorf("owner is not supported on MariaDB")
+}
+
+func (c *mariaDBSQLClient) DropSchema(ctx context.Context, name string) error {
+	return execDatabaseStatements(ctx, c.db, fmt.Sprintf("DROP SCHEMA IF EXISTS %s", quoteMariaDBIdentifier(name)))
+}
+
+func (c *mariaDBSQLClient) ReadPrivileges(ctx context.Context, user, host, schema string) ([]string, error) {
+	// GRANTEEは'user'@'host'形式で格納されている
+	grantee := fmt.Sprintf("'%s'@'%s'", user, host)
+	return queryDatabaseStrings(ctx, c.db,
+		"SELECT PRIVILEGE_TYPE FROM information_schema.SCHEMA_PRIVILEGES WHERE GRANTEE = ? AND TABLE_SCHEMA = ?", grantee, schema)
+}
+
+func (c *mariaDBSQLClient) Grant(ctx context.Context, user, host, schema string, privileges []string) error {
+	if len(privileges) == 0 {
+		return nil
+	}
+	return execDatabaseStatements(ctx, c.db, fmt.Sprintf("GRANT %s ON %s.* TO %s",
+		strings.Join(privileges, ", "), quoteMariaDBIdentifier(schema), mariaDBAccount(user, host)))
+}
+
+func (c *mariaDBSQLClient) Revoke(ctx context.Context, user, host, schema string, privileges []string) error {
+	if len(privileges) == 0 {
+		return nil
+	}
+	return execDatabaseStatements(ctx, c.db, fmt.Sprintf("REVOKE %s ON %s.* FROM %s",
+		strings.Join(privileges, ", "), quoteMariaDBIdentifier(schema), mariaDBAccount(user, host)))
+}
+
+func (c *mariaDBSQLClient) Close() error {
+	return c.db.Close()
+}
+
+/*
+ * PostgreSQL
+ */
+
+type postgresSQLClient struct {
+	db *sql.DB
+}
+
+func (c *postgresSQLClient) ReadUser(ctx context.Context, name, _ string) (bool, error) {
+	var count int
+	err := c.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM pg_roles WHERE rolname = $1", name).Scan(&count)
+	return count > 0, err
+}
+
+func (c *postgresSQLClient) CreateUser(ctx context.Context, name, _, password string) error {
+	return execDatabaseStatements(ctx, c.db,
+		fmt.Sprintf("CREATE ROLE %s WITH LOGIN PASSWORD %s", pq.QuoteIdentifier(name), pq.QuoteLiteral(password)))
+}
+
+func (c *postgresSQLClient) UpdateUserPassword(ctx context.Context, name, _, password string) error {
+	return execDatabaseStatements(ctx, c.db,
+		fmt.Sprintf("ALTER ROLE %s WITH PASSWORD %s", pq.QuoteIdentifier(name), pq.QuoteLiteral(password)))
+}
+
+func (c *postgresSQLClient) DropUser(ctx context.Context, name, _ string) error {
+	return execDatabaseStatements(ctx, c.db, fmt.Sprintf("DROP ROLE IF EXISTS %s", pq.QuoteIdentifier(name)))
+}
+
+func (c *postgresSQLClient) ReadSchema(ctx context.Context, name string) (string, bool, error) {
+	var owner string
+	err := c.db.QueryRowContext(ctx, "SELECT pg_get_userbyid(nspowner) FROM pg_namespace WHERE nspname = $1", name).Scan(&owner)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	return owner, err == nil, err
+}
+
+func (c *postgresSQLClient) CreateSchema(ctx context.Context, name, owner string) error {
+	stmt := fmt.Sprintf("CREATE SCHEMA %s", pq.QuoteIdentifier(name))
+	if owner != "" {
+		stmt += " AUTHORIZATION " + pq.QuoteIdentifier(owner)
+	}
+	return execDatabaseStatements(ctx, c.db, stmt)
+}
+
+func (c *postgresSQLClient) UpdateSchemaOwner(ctx context.Context, name, owner string) error {
+	return execDatabaseStatements(ctx, c.db,
+		fmt.Sprintf("ALTER SCHEMA %s OWNER TO %s", pq.QuoteIdentifier(name), pq.QuoteIdentifier(owner)))
+}
+
+func (c *postgresSQLClient) DropSchema(ctx context.Context, name string) error {
+	return execDatabaseStatements(ctx, c.db, fmt.Sprintf("DROP SCHEMA IF EXISTS %s", pq.QuoteIdentifier(name)))
+}
+
+func (c *postgresSQLClient) ReadPrivileges(ctx context.Context, user, _, schema string) ([]string, error) {
+	// スキーマ自体の権限と、スキーマ内のテーブルに対するデフォルト権限を合わせて返す
+	return queryDatabaseStrings(ctx, c.db, `
+SELECT p.privilege_type FROM pg_namespace n, aclexplode(n.nspacl) p
+ WHERE n.nspname = $1 AND p.grantee = (SELECT oid FROM pg_roles WHERE rolname = $2)
+UNION
+SELECT p.privilege_type FROM pg_default_acl a JOIN pg_namespace n ON n.oid = a.defaclnamespace, aclexplode(a.defaclacl) p
+ WHERE n.nspname = $1 AND a.defaclobjtype = 'r' AND p.grantee = (SELECT oid FROM pg_roles WHERE rolname = $2)`,
+		schema, user)
+}
+
+func (c *postgresSQLClient) Grant(ctx context.Context, user, _, schema string, privileges []string) error {
+	return execDatabaseStatements(ctx, c.db, postgresGrantStatements("GRANT", "TO", user, schema, privileges)...)
+}
+
+func (c *postgresSQLClient) Revoke(ctx context.Context, user, _, schema string, privileges []string) error {
+	return execDatabaseStatements(ctx, c.db, postgresGrantStatements("REVOKE", "FROM", user, schema, privileges)...)
+}
+
+func (c *postgresSQLClient) Close() error {
+	return c.db.Close()
+}
+
+// postgresGrantStatements GRANT/REVOKEを行うSQLを組み立てる
+//
+// テーブルに対する権限は既存テーブルに加え、デフォルト権限として今後作成されるテーブルにも適用する
+func postgresGrantStatements(verb, preposition, user, schema string, privileges []string) []string {
+	var schemaPrivileges, tablePrivileges []string
+	for _, p := range privileges {
+		if slices.Contains(databasePostgresSchemaPrivileges, p) {
+			schemaPrivileges = append(schemaPrivileges, p)
+		} else {
+			tablePrivileges = append(tablePrivileges, p)
+		}
+	}
+
+	var statements []string
+	if len(schemaPrivileges) > 0 {
+		statements = append(statements, fmt.Sprintf("%s %s ON SCHEMA %s %s %s",
+			verb, strings.Join(schemaPrivileges, ", "), pq.QuoteIdentifier(schema), preposition, pq.QuoteIdentifier(user)))
+	}
+	if len(tablePrivileges) > 0 {
+		privs := strings.Join(tablePrivileges, ", ")
+		statements = append(statements,
+			fmt.Sprintf("%s %s ON ALL TABLES IN SCHEMA %s %s %s",
+				verb, privs, pq.QuoteIdentifier(schema), preposition, pq.QuoteIdentifier(user)),
+			fmt.Sprintf("ALTER DEFAULT PRIVILEGES IN SCHEMA %s %s %s ON TABLES %s %s",
+				pq.QuoteIdentifier(schema), verb, privs, preposition, pq.QuoteIdentifier(user)),
+		)
+	}
+	return statements
+}
@@ -0,0 +1,299 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const dnsTXTCharacterStringMaxLen = 255
+
+var (
+	dnsHostNameLabelPattern = regexp.MustCompile(`^(?i)[a-z0-9_]([a-z0-9_-]{0,61}[a-z0-9_])?$`)
+	dnsSRVNamePattern       = regexp.MustCompile(`^_[^._]+\._[^._]+(\..+)?$`)
+	dnsCAAPattern           = regexp.MustCompile(`^(\d+)\s+([A-Za-z0-9]+)\s+(.+)$`)
+	dnsSVCBParamKeyPattern  = regexp.MustCompile(`^key[0-9]+$`)
+
+	dnsCAATags       = []string{"issue", "issuewild", "iodef", "issuemail", "issuevmc"}
+	dnsSVCBParamKeys = []string{"mandatory", "alpn", "no-default-alpn", "port", "ipv4hint", "ech", "ipv6hint"}
+)
+
+func resourceSakuraCloudDNSRecordCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return validateDNSRecordConfig(d.GetRawConfig())
+}
+
+func resourceSakuraCloudDNSCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	var messages []string
+	for i, record := range ctyListElements(d.GetRawConfig(), "record") {
+		if err := validateDNSRecordConfig(record); err != nil {
+			messages = append(messages, fmt.Sprintf("record.%d: %s", i, err))
+		}
+	}
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, "\n"))
+	}
+	return nil
+}
+
+// validateDNSRecordConfig name/type/valueが確定しているレコードをレコードタイプに応じて検証する
+func validateDNSRecordConfig(record cty.Value) error {
+	name, nameKnown := ctyString(record, "name")
+	recordType, typeKnown := ctyString(record, "type")
+	value, valueKnown := ctyString(record, "value")
+	if !nameKnown || !typeKnown || !valueKnown {
+		return nil
+	}
+	return validateDNSRecord(name, recordType, value)
+}
+
+// validateDNSRecord レコードタイプに応じてvalue(MX/SRVの場合はホスト名部分)を検証する
+func validateDNSRecord(name, recordType, value string) error {
+	var err error
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			err = errors.New("value must be an IPv4 address")
+		}
+	case "AAAA":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+			err = errors.New("value must be an IPv6 address")
+		}
+	case "CNAME", "NS", "ALIAS", "PTR":
+		err = validateDNSHostName(value)
+	case "MX":
+		if strings.ContainsAny(value, " \t") {
+			err = errors.New("value must be a host name, the preference must be specified by priority")
+		} else {
+			err = validateDNSHostName(value)
+		}
+	case "SRV":
+		if !dnsSRVNamePattern.MatchString(name) {
+			err = fmt.Errorf("name must be in the form of _service._proto: %q", name)
+		} else if strings.ContainsAny(value, " \t") {
+			err = errors.New("value must be a host name, the priority, weight and port must be specified by each attribute")
+		} else if value != "." {
+			err = validateDNSHostName(value)
+		}
+	case "TXT":
+		_, err = parseDNSTXTValue(value)
+	case "CAA":
+		_, err = parseDNSCAAValue(value)
+	case "HTTPS", "SVCB":
+		_, err = parseDNSSVCBValue(value)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s record: %s", recordType, err)
+	}
+	return nil
+}
+
+// suppressDNSRecordValueDiff 正規化後の値が同じ場合に差分を抑止する
+//
+// 同じ階層のtypeを参照するため、sakuracloud_dnsのrecordブロックとsakuracloud_dns_recordの双方で利用できる
+func suppressDNSRecordValueDiff(k, old, new string, d *schema.ResourceData) bool {
+	recordType, _ := d.Get(strings.TrimSuffix(k, "value") + "type").(string)
+	return normalizeDNSRecordValue(recordType, old) == normalizeDNSRecordValue(recordType, new)
+}
+
+// normalizeDNSRecordValue 比較用にレコードタイプに応じてvalueを正規化する
+//
+// ホスト名の大文字小文字、TXTレコードのクォートの有無などの表記揺れを吸収する。
+// ホスト名の末尾のドットはexpandDNSRecordで補完されるMX/SRVのみ無視し、それ以外では相対名と絶対名を区別する。
+// 不正な値の場合はそのまま返す。
+func normalizeDNSRecordValue(recordType, value string) string {
+	switch recordType {
+	case "A", "AAAA":
+		if ip := net.ParseIP(value); ip != nil {
+			return ip.String()
+		}
+	case "CNAME", "NS", "ALIAS", "PTR":
+		return strings.ToLower(value)
+	case "MX":
+		// RDataの場合は"10 example.com."形式となる
+		fields := strings.Fields(value)
+		if len(fields) > 0 {
+			fields[len(fields)-1] = normalizeDNSHostName(fields[len(fields)-1])
+		}
+		return strings.Join(fields, " ")
+	case "SRV":
+		fields := strings.Fields(value)
+		if len(fields) > 0 && fields[len(fields)-1] != "." {
+			fields[len(fields)-1] = normalizeDNSHostName(fields[len(fields)-1])
+		}
+		return strings.Join(fields, " ")
+	case "TXT":
+		if values, err := parseDNSTXTValue(value); err == nil {
+			return `"` + strings.Join(values, `" "`) + `"`
+		}
+	case "CAA":
+		if v, err := parseDNSCAAValue(value); err == nil {
+			return v
+		}
+	case "HTTPS", "SVCB":
+		if v, err := parseDNSSVCBValue(value); err == nil {
+			return v
+		}
+	}
+	return value
+}
+
+func validateDNSHostName(value string) error {
+	name := strings.TrimSuffix(value, ".")
+	if name == "" || len(name) > 253 {
+		return fmt.Errorf("value must be a host name: %q", value)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if !dnsHostNameLabelPattern.MatchString(label) {
+			return fmt.Errorf("value must be a host name: %q", value)
+		}
+	}
+	return nil
+}
+
+// normalizeDNSHostName 末尾のドットを補完して小文字化する
+func normalizeDNSHostName(value string) string {
+	if value == "" {
+		return value
+	}
+	return strings.ToLower(strings.TrimSuffix(value, ".")) + "."
+}
+
+// parseDNSTXTValue TXTレコードの値を文字列(character-string)のリストに分割する
+//
+// ダブルクォートで始まる場合は`"..." "..."`形式として扱い、それ以外は全体を1つの文字列として扱う。
+// 各文字列はエスケープを含むクォート内の表記のまま返す。
+func parseDNSTXTValue(value string) ([]string, error) {
+	if value == "" {
+		return nil, errors.New("value must not be empty")
+	}
+
+	var values []string
+	if !strings.HasPrefix(value, `"`) {
+		values = []string{strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)}
+	} else {
+		rest := value
+		for rest != "" {
+			if rest[0] != '"' {
+				return nil, fmt.Errorf("each string must be enclosed in double quotes: %q", value)
+			}
+			end := -1
+			for i := 1; i < len(rest); i++ {
+				if rest[i] == '\\' {
+					i++
+					continue
+				}
+				if rest[i] == '"' {
+					end = i
+					break
+				}
+			}
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated double quote: %q", value)
+			}
+			values = append(values, rest[1:end])
+			rest = strings.TrimLeft(rest[end+1:], " \t")
+		}
+	}
+
+	for _, v := range values {
+		unescaped := strings.NewReplacer(`\\`, `\`, `\"`, `"`).Replace(v)
+		if len(unescaped) > dnsTXTCharacterStringMaxLen {
+			return nil, fmt.Errorf("each string must be %d bytes or less, split the value into multiple quoted strings such as \"...\" \"...\"", dnsTXTCharacterStringMaxLen)
+		}
+	}
+	return values, nil
+}
+
+// parseDNSCAAValue `<flags> <tag> "<value>"`形式のCAAレコードの値を検証し、正規化した値を返す
+func parseDNSCAAValue(value string) (string, error) {
+	matches := dnsCAAPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if matches == nil {
+		return "", fmt.Errorf(`value must be in the form of <flags> <tag> "<value>": %q`, value)
+	}
+
+	flags, err := strconv.Atoi(matches[1])
+	if err != nil || flags > 255 {
+		return "", fmt.Errorf("flags must be in the range [0-255]: %q", matches[1])
+	}
+	tag := strings.ToLower(matches[2])
+	if !slices.Contains(dnsCAATags, tag) {
+		return "", fmt.Errorf("tag must be one of [%s]: %q", strings.Join(dnsCAATags, "/"), matches[2])
+	}
+
+	v := matches[3]
+	if strings.HasPrefix(v, `"`) {
+		if len(v) < 2 || !strings.HasSuffix(v, `"`) {
+			return "", fmt.Errorf("unterminated double quote: %q", value)
+		}
+		v = v[1 : len(v)-1]
+	} else if strings.ContainsAny(v, " \t") {
+		return "", fmt.Errorf("value containing spaces must be enclosed in double quotes: %q", value)
+	}
+	if tag == "iodef" && !strings.HasPrefix(v, "mailto:") && !strings.HasPrefix(v, "http://") && !strings.HasPrefix(v, "https://") {
+		return "", fmt.Errorf("value of iodef must be a mailto: or http(s): URL: %q", v)
+	}
+	return fmt.Sprintf(`%d %s "%s"`, flags, tag, v), nil
+}
+
+// parseDNSSVCBValue `<priority> <target> [<key>=<value>...]`形式のHTTPS/SVCBレコードの値を検証し、正規化した値を返す
+func parseDNSSVCBValue(value string) (string, error) {
+	fields := strings.Fields(value)
+	if len(fields) < 2 {
+		return "", fmt.Errorf("value must be in the form of <priority> <target> [<key>=<value>...]: %q", value)
+	}
+
+	priority, err := strconv.Atoi(fields[0])
+	if err != nil || priority < 0 || priority > 65535 {
+		return "", fmt.Errorf("priority must be in the range [0-65535]: %q", fields[0])
+	}
+	target := fields[1]
+	if target != "." {
+		if err := validateDNSHostName(target); err != nil {
+			return "", fmt.Errorf("target must be a host name or \".\": %q", target)
+		}
+		target = strings.ToLower(target)
+	}
+
+	params := fields[2:]
+	if priority == 0 && len(params) > 0 {
+		return "", errors.New("parameters cannot be specified when priority is 0 (AliasMode)")
+	}
+	for _, param := range params {
+		key, v, hasValue := strings.Cut(param, "=")
+		switch {
+		case key == "no-default-alpn":
+			if hasValue {
+				return "", fmt.Errorf("no-default-alpn cannot have a value: %q", param)
+			}
+		case slices.Contains(dnsSVCBParamKeys, key) || dnsSVCBParamKeyPattern.MatchString(key):
+			if !hasValue || v == "" {
+				return "", fmt.Errorf("parameter must be in the form of <key>=<value>: %q", param)
+			}
+		default:
+			return "", fmt.Errorf("unknown parameter key: %q", key)
+		}
+	}
+	return strings.Join(append([]string{strconv.Itoa(priority), target}, params...), " "), nil
+}
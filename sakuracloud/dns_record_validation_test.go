@@ -0,0 +1,129 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDNSRecord(t *testing.T) {
+	cases := []struct {
+		name       string
+		recordType string
+		value      string
+		wantErr    string
+	}{
+		{name: "www", recordType: "A", value: "192.0.2.1"},
+		{name: "www", recordType: "A", value: "2001:db8::1", wantErr: "value must be an IPv4 address"},
+		{name: "www", recordType: "AAAA", value: "2001:db8::1"},
+		{name: "www", recordType: "AAAA", value: "192.0.2.1", wantErr: "value must be an IPv6 address"},
+		{name: "www", recordType: "CNAME", value: "www.example.com."},
+		{name: "www", recordType: "CNAME", value: "selector._domainkey.example.com"},
+		{name: "www", recordType: "CNAME", value: "www..example.com", wantErr: "value must be a host name"},
+		{name: "@", recordType: "NS", value: "ns1.example.com"},
+		{name: "@", recordType: "ALIAS", value: "example.sakura.ne.jp."},
+		{name: "1", recordType: "PTR", value: "www.example.com."},
+		{name: "@", recordType: "MX", value: "mail.example.com"},
+		{name: "@", recordType: "MX", value: "10 mail.example.com", wantErr: "priority"},
+		{name: "_sip._tcp", recordType: "SRV", value: "sip.example.com."},
+		{name: "_sip._tcp", recordType: "SRV", value: "."},
+		{name: "sip", recordType: "SRV", value: "sip.example.com.", wantErr: "name must be in the form of _service._proto"},
+		{name: "_sip._tcp", recordType: "SRV", value: "0 5 5060 sip.example.com.", wantErr: "priority, weight and port"},
+		{name: "@", recordType: "TXT", value: "v=spf1 include:example.com ~all"},
+		{name: "@", recordType: "TXT", value: `"foo" "bar"`},
+		{name: "@", recordType: "TXT", value: strings.Repeat("a", 256), wantErr: "255 bytes or less"},
+		{name: "@", recordType: "TXT", value: `"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("b", 255) + `"`},
+		{name: "@", recordType: "TXT", value: `"foo`, wantErr: "unterminated double quote"},
+		{name: "@", recordType: "TXT", value: `"foo" bar`, wantErr: "enclosed in double quotes"},
+		{name: "@", recordType: "TXT", value: "", wantErr: "must not be empty"},
+		{name: "@", recordType: "CAA", value: `0 issue "letsencrypt.org"`},
+		{name: "@", recordType: "CAA", value: `128 issuewild ;`},
+		{name: "@", recordType: "CAA", value: `0 iodef "mailto:security@example.com"`},
+		{name: "@", recordType: "CAA", value: `0 iodef "security@example.com"`, wantErr: "mailto:"},
+		{name: "@", recordType: "CAA", value: `256 issue "letsencrypt.org"`, wantErr: "flags must be in the range"},
+		{name: "@", recordType: "CAA", value: `0 foo "letsencrypt.org"`, wantErr: "tag must be one of"},
+		{name: "@", recordType: "CAA", value: `letsencrypt.org`, wantErr: "<flags> <tag>"},
+		{name: "@", recordType: "HTTPS", value: `1 . alpn=h2,h3 ipv4hint=192.0.2.1`},
+		{name: "@", recordType: "HTTPS", value: `0 www.example.com.`},
+		{name: "@", recordType: "SVCB", value: `1 svc.example.com. port=8443 no-default-alpn key65000=foo`},
+		{name: "@", recordType: "HTTPS", value: `0 www.example.com. alpn=h2`, wantErr: "AliasMode"},
+		{name: "@", recordType: "HTTPS", value: `1 . foo=bar`, wantErr: "unknown parameter key"},
+		{name: "@", recordType: "HTTPS", value: `1 . alpn`, wantErr: "<key>=<value>"},
+		{name: "@", recordType: "SVCB", value: `x .`, wantErr: "priority must be in the range"},
+		{name: "@", recordType: "SVCB", value: `1`, wantErr: "<priority> <target>"},
+	}
+
+	for _, tc := range cases {
+		err := validateDNSRecord(tc.name, tc.recordType, tc.value)
+		if tc.wantErr == "" {
+			require.NoError(t, err, tc.value)
+			continue
+		}
+		require.Error(t, err, tc.value)
+		require.Contains(t, err.Error(), tc.wantErr, tc.value)
+	}
+}
+
+func TestNormalizeDNSRecordValue(t *testing.T) {
+	cases := []struct {
+		recordType string
+		v1         string
+		v2         string
+	}{
+		{recordType: "AAAA", v1: "2001:db8:0:0::1", v2: "2001:DB8::1"},
+		{recordType: "CNAME", v1: "www.example.com.", v2: "WWW.example.com."},
+		{recordType: "NS", v1: "ns1.example.com", v2: "NS1.Example.com"},
+		{recordType: "MX", v1: "mail.example.com", v2: "mail.example.com."},
+		{recordType: "MX", v1: "10 mail.example.com", v2: "10 mail.example.com."},
+		{recordType: "SRV", v1: "0 5 5060 sip.example.com", v2: "0 5 5060 sip.example.com."},
+		{recordType: "TXT", v1: "v=spf1 -all", v2: `"v=spf1 -all"`},
+		{recordType: "TXT", v1: `"foo"   "bar"`, v2: `"foo" "bar"`},
+		{recordType: "TXT", v1: `say "hello"`, v2: `"say \"hello\""`},
+		{recordType: "CAA", v1: `0 ISSUE letsencrypt.org`, v2: `0 issue "letsencrypt.org"`},
+		{recordType: "HTTPS", v1: "1  www.example.com.  alpn=h2", v2: "1 WWW.example.com. alpn=h2"},
+	}
+	for _, tc := range cases {
+		require.Equal(t, normalizeDNSRecordValue(tc.recordType, tc.v1), normalizeDNSRecordValue(tc.recordType, tc.v2), tc.v1)
+	}
+
+	require.NotEqual(t, normalizeDNSRecordValue("TXT", `"foo" "bar"`), normalizeDNSRecordValue("TXT", `foo bar`))
+	require.NotEqual(t, normalizeDNSRecordValue("CNAME", "www1.example.com"), normalizeDNSRecordValue("CNAME", "www2.example.com"))
+	// 末尾のドットが補完されないレコードでは相対名と絶対名を区別する
+	for _, recordType := range []string{"CNAME", "NS", "ALIAS", "PTR"} {
+		require.NotEqual(t, normalizeDNSRecordValue(recordType, "www"), normalizeDNSRecordValue(recordType, "www."), recordType)
+	}
+	require.NotEqual(t, normalizeDNSRecordValue("HTTPS", "1 www alpn=h2"), normalizeDNSRecordValue("HTTPS", "1 www. alpn=h2"))
+}
+
+func TestValidateDNSRecordConfig(t *testing.T) {
+	record := func(recordType, value cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name":  cty.StringVal("www"),
+			"type":  recordType,
+			"value": value,
+		})
+	}
+
+	require.NoError(t, validateDNSRecordConfig(record(cty.StringVal("A"), cty.StringVal("192.0.2.1"))))
+	require.NoError(t, validateDNSRecordConfig(record(cty.StringVal("A"), cty.UnknownVal(cty.String))))
+	require.EqualError(t,
+		validateDNSRecordConfig(record(cty.StringVal("A"), cty.StringVal("example.com"))),
+		"invalid A record: value must be an IPv4 address",
+	)
+}
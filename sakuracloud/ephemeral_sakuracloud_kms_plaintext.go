@@ -0,0 +1,86 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*ephemeralKMSPlaintext)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*ephemeralKMSPlaintext)(nil)
+)
+
+// ephemeralKMSPlaintext KMSのキーで暗号文を復号し、復号結果をplan/stateに保存せずに参照するためのエフェメラルリソース
+type ephemeralKMSPlaintext struct {
+	client *APIClient
+}
+
+func newEphemeralKMSPlaintext() ephemeral.EphemeralResource {
+	return &ephemeralKMSPlaintext{}
+}
+
+func (e *ephemeralKMSPlaintext) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_plaintext"
+}
+
+func (e *ephemeralKMSPlaintext) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the KMS key used for decryption. This is same as `kms_key_id`",
+			},
+			"kms_key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the KMS key used for decryption",
+			},
+			"ciphertext": schema.StringAttribute{
+				Required:    true,
+				Description: "The ciphertext encrypted by the KMS key",
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The decrypted result of `ciphertext`",
+			},
+		},
+	}
+}
+
+func (e *ephemeralKMSPlaintext) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.client = frameworkAPIClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (e *ephemeralKMSPlaintext) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model kmsPlaintextModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if e.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The provider must be configured before opening sakuracloud_kms_plaintext")
+		return
+	}
+
+	resp.Diagnostics.Append(decryptKMSPlaintext(ctx, e.client, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
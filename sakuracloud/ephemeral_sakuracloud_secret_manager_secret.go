@@ -0,0 +1,115 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sm "github.com/sacloud/secretmanager-api-go"
+	v1 "github.com/sacloud/secretmanager-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakuracloud/internal/desc"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*ephemeralSecretManagerSecret)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*ephemeralSecretManagerSecret)(nil)
+)
+
+// ephemeralSecretManagerSecret シークレットの値をplan/stateに保存せずに参照するためのエフェメラルリソース
+type ephemeralSecretManagerSecret struct {
+	client *APIClient
+}
+
+type ephemeralSecretManagerSecretModel struct {
+	Name    types.String `tfsdk:"name"`
+	VaultID types.String `tfsdk:"vault_id"`
+	Version types.Int64  `tfsdk:"version"`
+	Value   types.String `tfsdk:"value"`
+}
+
+func newEphemeralSecretManagerSecret() ephemeral.EphemeralResource {
+	return &ephemeralSecretManagerSecret{}
+}
+
+func (e *ephemeralSecretManagerSecret) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_manager_secret"
+}
+
+func (e *ephemeralSecretManagerSecret) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	const resourceName = "SecretManagerSecret"
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: desc.Sprintf("The name of the %s", resourceName),
+			},
+			"vault_id": schema.StringAttribute{
+				Required:    true,
+				Description: desc.Sprintf("The secret manager's vault id of the %s", resourceName),
+			},
+			"version": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Target version to unveil stored secret. Without this parameter, latest version is used",
+			},
+			"value": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Unveiled result of stored secret",
+			},
+		},
+	}
+}
+
+func (e *ephemeralSecretManagerSecret) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.client = frameworkAPIClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (e *ephemeralSecretManagerSecret) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var model ephemeralSecretManagerSecretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if e.client == nil {
+		resp.Diagnostics.AddError("Provider not configured", "The provider must be configured before opening sakuracloud_secret_manager_secret")
+		return
+	}
+
+	secretOp := sm.NewSecretOp(e.client.secretmanagerClient, model.VaultID.ValueString())
+
+	unveilReq := v1.Unveil{Name: model.Name.ValueString()}
+	if !model.Version.IsNull() && !model.Version.IsUnknown() {
+		unveilReq.Version = v1.NewOptNilInt(int(model.Version.ValueInt64()))
+	}
+	unveil, err := secretOp.Unveil(ctx, unveilReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unveil failed",
+			fmt.Sprintf("could not unveil SecretManagerSecret[%s] secret: %s", model.Name.ValueString(), err),
+		)
+		return
+	}
+
+	model.Value = types.StringValue(unveil.Value)
+	if unveil.Version.IsSet() && !unveil.Version.IsNull() {
+		model.Version = types.Int64Value(int64(unveil.Version.Value))
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &model)...)
+}
@@ -17,13 +17,21 @@ package sakuracloud
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	return protoV5ProviderServerFactory(ctx, Provider())
+}
+
+func protoV5ProviderServerFactory(ctx context.Context, primary *schema.Provider) (func() tfprotov5.ProviderServer, error) {
 	providers := []func() tfprotov5.ProviderServer{
-		Provider().GRPCProvider,
+		// frameworkProviderはprimaryの設定結果を参照するため、primaryを先に登録しておくこと
+		primary.GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider(primary)),
 	}
 
 	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
@@ -0,0 +1,493 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// firewallRule パケットフィルタやVPCルータのファイアウォールのルール(expression)
+type firewallRule struct {
+	protocol           string
+	sourceNetwork      string
+	sourcePort         string
+	destinationNetwork string
+	destinationPort    string
+}
+
+// firewallRuleSet 同じインターフェース/方向に対して上から順に評価されるルールのまとまり
+type firewallRuleSet struct {
+	// path エラー/警告メッセージに表示する属性のパス
+	path string
+	// key VPCルータのファイアウォールのインターフェース/方向、パケットフィルタの場合は空
+	key string
+	// rules 未確定(unknown)の値を含むルールはnilとなる
+	rules []*firewallRule
+	// format ルールの各項目の書式
+	format *firewallRuleFormat
+}
+
+// firewallRuleFormat ルールの各項目の書式。パケットフィルタとVPCルータのファイアウォールで受け付ける書式が異なる
+type firewallRuleFormat struct {
+	parseNetwork func(string) (*firewallNetwork, error)
+	parsePort    func(string) (*firewallPort, error)
+	// portProtocols source_port/destination_portを指定可能なプロトコル
+	portProtocols []string
+}
+
+var (
+	// packetFilterRuleFormat types.PacketFilterNetwork/types.PacketFilterPortの書式
+	packetFilterRuleFormat = &firewallRuleFormat{
+		parseNetwork:  parsePacketFilterNetwork,
+		parsePort:     parsePacketFilterPort,
+		portProtocols: []string{"http", "https", "tcp", "udp"},
+	}
+	// vpcRouterFirewallRuleFormat types.VPCFirewallNetwork/types.VPCFirewallPortの書式
+	vpcRouterFirewallRuleFormat = &firewallRuleFormat{
+		parseNetwork:  parseVPCRouterFirewallNetwork,
+		parsePort:     parseVPCRouterFirewallPort,
+		portProtocols: []string{"tcp", "udp"},
+	}
+)
+
+func resourceSakuraCloudVPCRouterFirewallCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return validateFirewallRuleSets(vpcRouterFirewallRuleSets(d.GetRawConfig()))
+}
+
+func resourceSakuraCloudVPCRouterFirewallValidateRawConfig(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	resp.Diagnostics = append(resp.Diagnostics, shadowedFirewallRuleWarnings(vpcRouterFirewallRuleSets(req.RawConfig))...)
+}
+
+func resourceSakuraCloudPacketFilterRulesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return validateFirewallRuleSets(packetFilterRuleSets(d.GetRawConfig()))
+}
+
+func resourceSakuraCloudPacketFilterRulesValidateRawConfig(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	resp.Diagnostics = append(resp.Diagnostics, shadowedFirewallRuleWarnings(packetFilterRuleSets(req.RawConfig))...)
+}
+
+func vpcRouterFirewallRuleSets(config cty.Value) []*firewallRuleSet {
+	var sets []*firewallRuleSet
+	for i, firewall := range ctyListElements(config, "firewall") {
+		path := fmt.Sprintf("firewall.%d", i)
+		set := &firewallRuleSet{path: path, format: vpcRouterFirewallRuleFormat}
+
+		index, indexKnown := ctyInt(firewall, "interface_index")
+		direction, directionKnown := ctyString(firewall, "direction")
+		if indexKnown && directionKnown {
+			set.key = fmt.Sprintf("interface_index=%d, direction=%s", index, direction)
+		}
+
+		for _, expression := range ctyListElements(firewall, "expression") {
+			set.rules = append(set.rules, expandFirewallRuleFromCty(expression))
+		}
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+func packetFilterRuleSets(config cty.Value) []*firewallRuleSet {
+	set := &firewallRuleSet{path: "", format: packetFilterRuleFormat}
+	for _, expression := range ctyListElements(config, "expression") {
+		set.rules = append(set.rules, expandFirewallRuleFromCty(expression))
+	}
+	return []*firewallRuleSet{set}
+}
+
+// expandFirewallRuleFromCty 未確定の値を含む場合はnilを返す
+func expandFirewallRuleFromCty(v cty.Value) *firewallRule {
+	rule := &firewallRule{}
+	known := true
+	for name, dest := range map[string]*string{
+		"protocol":            &rule.protocol,
+		"source_network":      &rule.sourceNetwork,
+		"source_port":         &rule.sourcePort,
+		"destination_network": &rule.destinationNetwork,
+		"destination_port":    &rule.destinationPort,
+	} {
+		s, ok := ctyString(v, name)
+		known = known && ok
+		*dest = s
+	}
+	if !known {
+		return nil
+	}
+	return rule
+}
+
+func validateFirewallRuleSets(sets []*firewallRuleSet) error {
+	var err error
+	keys := make(map[string]string)
+	for _, set := range sets {
+		if set.key != "" {
+			if prev, ok := keys[set.key]; ok {
+				err = multierror.Append(err, fmt.Errorf("%s: %s is already declared in %s", set.path, set.key, prev))
+			}
+			keys[set.key] = set.path
+		}
+		for i, rule := range set.rules {
+			if rule == nil {
+				continue
+			}
+			if e := validateFirewallRule(rule, set.format); e != nil {
+				err = multierror.Append(err, fmt.Errorf("%s: %s", firewallRulePath(set, i), e))
+			}
+		}
+	}
+	return err
+}
+
+func validateFirewallRule(rule *firewallRule, format *firewallRuleFormat) error {
+	var messages []string
+	if !slices.Contains(format.portProtocols, rule.protocol) {
+		if rule.sourcePort != "" || rule.destinationPort != "" {
+			messages = append(messages, fmt.Sprintf(
+				"source_port and destination_port can only be specified when protocol is one of [%s]: protocol=%q",
+				strings.Join(format.portProtocols, "/"), rule.protocol,
+			))
+		}
+	}
+	if _, err := format.parseNetwork(rule.sourceNetwork); err != nil {
+		messages = append(messages, fmt.Sprintf("source_network is invalid: %s", err))
+	}
+	if _, err := format.parseNetwork(rule.destinationNetwork); err != nil {
+		messages = append(messages, fmt.Sprintf("destination_network is invalid: %s", err))
+	}
+	if _, err := format.parsePort(rule.sourcePort); err != nil {
+		messages = append(messages, fmt.Sprintf("source_port is invalid: %s", err))
+	}
+	if _, err := format.parsePort(rule.destinationPort); err != nil {
+		messages = append(messages, fmt.Sprintf("destination_port is invalid: %s", err))
+	}
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, ", "))
+	}
+	return nil
+}
+
+func shadowedFirewallRuleWarnings(sets []*firewallRuleSet) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, set := range sets {
+		for _, pair := range findShadowedFirewallRules(set.rules, set.format) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Shadowed firewall rule",
+				Detail: fmt.Sprintf(
+					"%s can never match because %s covers all packets matched by it",
+					firewallRulePath(set, pair[0]), firewallRulePath(set, pair[1]),
+				),
+			})
+		}
+	}
+	return diags
+}
+
+// findShadowedFirewallRules 前方のルールに完全に包含されているルールを探す
+//
+// 戻り値の各要素は[包含されているルールのインデックス, 包含している最初のルールのインデックス]となる
+func findShadowedFirewallRules(rules []*firewallRule, format *firewallRuleFormat) [][2]int {
+	var results [][2]int
+	for i, rule := range rules {
+		if rule == nil || validateFirewallRule(rule, format) != nil {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			prev := rules[j]
+			if prev == nil || validateFirewallRule(prev, format) != nil {
+				continue
+			}
+			if firewallRuleCovers(prev, rule, format) {
+				results = append(results, [2]int{i, j})
+				break
+			}
+		}
+	}
+	return results
+}
+
+func firewallRulePath(set *firewallRuleSet, index int) string {
+	if set.path == "" {
+		return fmt.Sprintf("expression.%d", index)
+	}
+	return fmt.Sprintf("%s.expression.%d", set.path, index)
+}
+
+// firewallRuleCovers aにマッチしないパケットはbにもマッチしない場合にtrueを返す
+func firewallRuleCovers(a, b *firewallRule, format *firewallRuleFormat) bool {
+	if a.protocol != "ip" && a.protocol != b.protocol {
+		return false
+	}
+	for _, pair := range [][2]string{{a.sourceNetwork, b.sourceNetwork}, {a.destinationNetwork, b.destinationNetwork}} {
+		outer, _ := format.parseNetwork(pair[0])
+		inner, _ := format.parseNetwork(pair[1])
+		if !outer.covers(inner) {
+			return false
+		}
+	}
+	for _, pair := range [][2]string{{a.sourcePort, b.sourcePort}, {a.destinationPort, b.destinationPort}} {
+		outer, _ := format.parsePort(pair[0])
+		inner, _ := format.parsePort(pair[1])
+		if !outer.covers(inner) {
+			return false
+		}
+	}
+	return true
+}
+
+// firewallNetwork アドレスの範囲、nilの場合は全てのアドレスを表す
+type firewallNetwork struct {
+	from netip.Addr
+	to   netip.Addr
+}
+
+func (n *firewallNetwork) covers(other *firewallNetwork) bool {
+	if n == nil {
+		return true
+	}
+	if other == nil || n.from.BitLen() != other.from.BitLen() {
+		return false
+	}
+	return n.from.Compare(other.from) <= 0 && other.to.Compare(n.to) <= 0
+}
+
+// parsePacketFilterNetwork 192.0.2.1, 192.0.2.0/24, 192.0.2.10/192.0.2.20(範囲指定) の形式を受け付ける
+func parsePacketFilterNetwork(v string) (*firewallNetwork, error) {
+	if v == "" {
+		return nil, nil
+	}
+	if from, to, found := strings.Cut(v, "/"); found {
+		if toAddr, err := netip.ParseAddr(to); err == nil {
+			fromAddr, err := netip.ParseAddr(from)
+			if err != nil {
+				return nil, err
+			}
+			if fromAddr.BitLen() != toAddr.BitLen() || toAddr.Less(fromAddr) {
+				return nil, fmt.Errorf("invalid address range: %q", v)
+			}
+			return &firewallNetwork{from: fromAddr, to: toAddr}, nil
+		}
+	}
+	return parseFirewallAddressOrPrefix(v)
+}
+
+// parseVPCRouterFirewallNetwork 192.0.2.1, 192.0.2.0/24 の形式を受け付ける
+func parseVPCRouterFirewallNetwork(v string) (*firewallNetwork, error) {
+	if v == "" {
+		return nil, nil
+	}
+	return parseFirewallAddressOrPrefix(v)
+}
+
+func parseFirewallAddressOrPrefix(v string) (*firewallNetwork, error) {
+	if strings.Contains(v, "/") {
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return nil, err
+		}
+		prefix = prefix.Masked()
+		return &firewallNetwork{from: prefix.Addr(), to: lastAddrOfPrefix(prefix)}, nil
+	}
+	addr, err := netip.ParseAddr(v)
+	if err != nil {
+		return nil, err
+	}
+	return &firewallNetwork{from: addr, to: addr}, nil
+}
+
+func lastAddrOfPrefix(prefix netip.Prefix) netip.Addr {
+	b := prefix.Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 1 << uint(7-i%8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+// firewallPort ポートの範囲の集合、nilの場合は全てのポートを表す
+type firewallPort struct {
+	ranges []firewallPortRange
+}
+
+type firewallPortRange struct {
+	from int
+	to   int
+}
+
+// covers otherの全ての範囲がいずれかの範囲に含まれる場合にtrueを返す
+func (p *firewallPort) covers(other *firewallPort) bool {
+	if p == nil {
+		return true
+	}
+	if other == nil {
+		return false
+	}
+	for _, inner := range other.ranges {
+		if !slices.ContainsFunc(p.ranges, func(outer firewallPortRange) bool {
+			return outer.from <= inner.from && inner.to <= outer.to
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// parsePacketFilterPort 0～65535 の整数、またはその範囲指定(ハイフン区切り)の形式を受け付ける
+func parsePacketFilterPort(v string) (*firewallPort, error) {
+	if v == "" {
+		return nil, nil
+	}
+	r, err := parseFirewallPortRange(v, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &firewallPort{ranges: []firewallPortRange{r}}, nil
+}
+
+// vpcRouterFirewallMaxPorts VPCルータのファイアウォールでコンマ区切りで指定可能なポートの数
+const vpcRouterFirewallMaxPorts = 6
+
+// parseVPCRouterFirewallPort 1～65535 の整数、その範囲指定(ハイフン区切り)、または複数指定(コンマ区切り 6個まで)の形式を受け付ける
+func parseVPCRouterFirewallPort(v string) (*firewallPort, error) {
+	if v == "" {
+		return nil, nil
+	}
+	values := strings.Split(v, ",")
+	if len(values) > vpcRouterFirewallMaxPorts {
+		return nil, fmt.Errorf("up to %d ports can be specified: %q", vpcRouterFirewallMaxPorts, v)
+	}
+	port := &firewallPort{}
+	for _, value := range values {
+		r, err := parseFirewallPortRange(value, 1)
+		if err != nil {
+			return nil, err
+		}
+		port.ranges = append(port.ranges, r)
+	}
+	return port, nil
+}
+
+func parseFirewallPortRange(v string, minPort int) (firewallPortRange, error) {
+	from, to, found := strings.Cut(v, "-")
+	if !found {
+		to = from
+	}
+	fromPort, err := parseFirewallPortNumber(from, minPort)
+	if err != nil {
+		return firewallPortRange{}, err
+	}
+	toPort, err := parseFirewallPortNumber(to, minPort)
+	if err != nil {
+		return firewallPortRange{}, err
+	}
+	if toPort < fromPort {
+		return firewallPortRange{}, fmt.Errorf("invalid port range: %q", v)
+	}
+	return firewallPortRange{from: fromPort, to: toPort}, nil
+}
+
+func parseFirewallPortNumber(v string, minPort int) (int, error) {
+	port, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil || port < minPort || 65535 < port {
+		return 0, fmt.Errorf("port number must be in the range [%d-65535]: %q", minPort, v)
+	}
+	return port, nil
+}
+
+// ctyListElements objの属性nameのリストの要素を返す、未確定やnullの場合は空となる
+func ctyListElements(obj cty.Value, name string) []cty.Value {
+	if obj.IsNull() || !obj.IsKnown() || !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+		return nil
+	}
+	v := obj.GetAttr(name)
+	if v.IsNull() || !v.IsKnown() || !v.CanIterateElements() {
+		return nil
+	}
+	var results []cty.Value
+	for it := v.ElementIterator(); it.Next(); {
+		_, e := it.Element()
+		results = append(results, e)
+	}
+	return results
+}
+
+// ctyIsEmpty objの属性nameが未指定(nullまたは要素を持たないブロック)であるかを返す。未確定の場合はfalseとなる
+func ctyIsEmpty(obj cty.Value, name string) bool {
+	if obj.IsNull() {
+		return true
+	}
+	if !obj.IsKnown() || !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+		return false
+	}
+	v := obj.GetAttr(name)
+	if v.IsNull() {
+		return true
+	}
+	return v.IsKnown() && v.CanIterateElements() && v.LengthInt() == 0
+}
+
+// ctyString objの属性nameの値を返す、nullや属性が存在しない場合は空文字となる。値が未確定の場合は2番目の戻り値がfalseとなる
+func ctyString(obj cty.Value, name string) (string, bool) {
+	if !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+		return "", true
+	}
+	v := obj.GetAttr(name)
+	if !v.IsKnown() {
+		return "", false
+	}
+	if v.IsNull() || !v.Type().Equals(cty.String) {
+		return "", true
+	}
+	return v.AsString(), true
+}
+
+func ctyInt(obj cty.Value, name string) (int, bool) {
+	if !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+		return 0, true
+	}
+	v := obj.GetAttr(name)
+	if !v.IsKnown() {
+		return 0, false
+	}
+	if v.IsNull() || !v.Type().Equals(cty.Number) {
+		return 0, true
+	}
+	i, _ := v.AsBigFloat().Int64()
+	return int(i), true
+}
+
+func ctyBool(obj cty.Value, name string) (bool, bool) {
+	if !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+		return false, true
+	}
+	v := obj.GetAttr(name)
+	if !v.IsKnown() {
+		return false, false
+	}
+	if v.IsNull() || !v.Type().Equals(cty.Bool) {
+		return false, true
+	}
+	return v.True(), true
+}
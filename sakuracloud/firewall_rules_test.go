@@ -0,0 +1,243 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFirewallNetwork(t *testing.T) {
+	cases := []struct {
+		parse   func(string) (*firewallNetwork, error)
+		in      string
+		from    string
+		to      string
+		wantErr bool
+	}{
+		{parse: parsePacketFilterNetwork, in: "", from: "", to: ""},
+		{parse: parsePacketFilterNetwork, in: "192.0.2.1", from: "192.0.2.1", to: "192.0.2.1"},
+		{parse: parsePacketFilterNetwork, in: "192.0.2.0/24", from: "192.0.2.0", to: "192.0.2.255"},
+		{parse: parsePacketFilterNetwork, in: "192.0.2.1/24", from: "192.0.2.0", to: "192.0.2.255"},
+		// types.PacketFilterNetwork.SetAddressRangeの書式
+		{parse: parsePacketFilterNetwork, in: "192.168.0.10/192.168.0.20", from: "192.168.0.10", to: "192.168.0.20"},
+		{parse: parsePacketFilterNetwork, in: "192.0.2.0/255.255.255.0", from: "192.0.2.0", to: "255.255.255.0"},
+		{parse: parsePacketFilterNetwork, in: "192.168.0.20/192.168.0.10", wantErr: true},
+		{parse: parsePacketFilterNetwork, in: "192.0.2.10-192.0.2.20", wantErr: true},
+		{parse: parsePacketFilterNetwork, in: "192.0.2.0/33", wantErr: true},
+		{parse: parsePacketFilterNetwork, in: "192.0.2.256", wantErr: true},
+		{parse: parsePacketFilterNetwork, in: "example.com", wantErr: true},
+
+		{parse: parseVPCRouterFirewallNetwork, in: "", from: "", to: ""},
+		{parse: parseVPCRouterFirewallNetwork, in: "192.0.2.1", from: "192.0.2.1", to: "192.0.2.1"},
+		{parse: parseVPCRouterFirewallNetwork, in: "192.0.2.0/24", from: "192.0.2.0", to: "192.0.2.255"},
+		{parse: parseVPCRouterFirewallNetwork, in: "192.168.0.10/192.168.0.20", wantErr: true},
+		{parse: parseVPCRouterFirewallNetwork, in: "192.0.2.10-192.0.2.20", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		got, err := tc.parse(tc.in)
+		if tc.wantErr {
+			require.Error(t, err, tc.in)
+			continue
+		}
+		require.NoError(t, err, tc.in)
+		if tc.from == "" {
+			require.Nil(t, got, tc.in)
+			continue
+		}
+		require.Equal(t, tc.from, got.from.String(), tc.in)
+		require.Equal(t, tc.to, got.to.String(), tc.in)
+	}
+}
+
+func TestParseFirewallPort(t *testing.T) {
+	cases := []struct {
+		parse   func(string) (*firewallPort, error)
+		in      string
+		want    *firewallPort
+		wantErr bool
+	}{
+		{parse: parsePacketFilterPort, in: "", want: nil},
+		{parse: parsePacketFilterPort, in: "0", want: &firewallPort{ranges: []firewallPortRange{{from: 0, to: 0}}}},
+		{parse: parsePacketFilterPort, in: "80", want: &firewallPort{ranges: []firewallPortRange{{from: 80, to: 80}}}},
+		{parse: parsePacketFilterPort, in: "1024-2048", want: &firewallPort{ranges: []firewallPortRange{{from: 1024, to: 2048}}}},
+		{parse: parsePacketFilterPort, in: "80,443", wantErr: true},
+		{parse: parsePacketFilterPort, in: "65536", wantErr: true},
+		{parse: parsePacketFilterPort, in: "2048-1024", wantErr: true},
+		{parse: parsePacketFilterPort, in: "http", wantErr: true},
+
+		{parse: parseVPCRouterFirewallPort, in: "", want: nil},
+		{parse: parseVPCRouterFirewallPort, in: "80", want: &firewallPort{ranges: []firewallPortRange{{from: 80, to: 80}}}},
+		{parse: parseVPCRouterFirewallPort, in: "80,443,8000-8080", want: &firewallPort{ranges: []firewallPortRange{{from: 80, to: 80}, {from: 443, to: 443}, {from: 8000, to: 8080}}}},
+		{parse: parseVPCRouterFirewallPort, in: "1,2,3,4,5,6,7", wantErr: true},
+		{parse: parseVPCRouterFirewallPort, in: "0", wantErr: true},
+		{parse: parseVPCRouterFirewallPort, in: "65536", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		got, err := tc.parse(tc.in)
+		if tc.wantErr {
+			require.Error(t, err, tc.in)
+			continue
+		}
+		require.NoError(t, err, tc.in)
+		require.Equal(t, tc.want, got, tc.in)
+	}
+}
+
+func testFirewallExpressionValue(protocol, sourceNetwork, sourcePort, destinationPort string) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"protocol":            cty.StringVal(protocol),
+		"source_network":      cty.StringVal(sourceNetwork),
+		"source_port":         cty.StringVal(sourcePort),
+		"destination_network": cty.NullVal(cty.String),
+		"destination_port":    cty.StringVal(destinationPort),
+		"allow":               cty.True,
+	})
+}
+
+func testVPCRouterFirewallConfig(firewalls ...cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"firewall": cty.ListVal(firewalls),
+	})
+}
+
+func testVPCRouterFirewallValue(index int, direction string, expressions ...cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"interface_index": cty.NumberIntVal(int64(index)),
+		"direction":       cty.StringVal(direction),
+		"expression":      cty.ListVal(expressions),
+	})
+}
+
+func TestValidateFirewallRuleSets(t *testing.T) {
+	cases := []struct {
+		msg     string
+		in      []*firewallRuleSet
+		wantErr []string
+	}{
+		{
+			msg: "valid",
+			in: vpcRouterFirewallRuleSets(testVPCRouterFirewallConfig(
+				testVPCRouterFirewallValue(0, "receive",
+					testFirewallExpressionValue("tcp", "192.0.2.0/24", "", "22"),
+					testFirewallExpressionValue("icmp", "", "", ""),
+				),
+				testVPCRouterFirewallValue(0, "send",
+					testFirewallExpressionValue("ip", "", "", ""),
+				),
+			)),
+		},
+		{
+			msg: "port with icmp",
+			in: packetFilterRuleSets(cty.ObjectVal(map[string]cty.Value{
+				"expression": cty.ListVal([]cty.Value{
+					testFirewallExpressionValue("icmp", "", "", "22"),
+				}),
+			})),
+			wantErr: []string{"expression.0: source_port and destination_port can only be specified when protocol is one of [http/https/tcp/udp]"},
+		},
+		{
+			msg: "packet filter formats",
+			in: packetFilterRuleSets(cty.ObjectVal(map[string]cty.Value{
+				"expression": cty.ListVal([]cty.Value{
+					testFirewallExpressionValue("http", "192.168.0.10/192.168.0.20", "0", "80"),
+				}),
+			})),
+		},
+		{
+			msg: "vpc router firewall formats",
+			in: vpcRouterFirewallRuleSets(testVPCRouterFirewallConfig(
+				testVPCRouterFirewallValue(0, "receive",
+					testFirewallExpressionValue("tcp", "192.168.0.10/192.168.0.20", "", "80,443"),
+				),
+			)),
+			wantErr: []string{"firewall.0.expression.0: source_network is invalid"},
+		},
+		{
+			msg: "invalid network and port",
+			in: vpcRouterFirewallRuleSets(testVPCRouterFirewallConfig(
+				testVPCRouterFirewallValue(1, "receive",
+					testFirewallExpressionValue("tcp", "192.0.2.0/33", "", "22-21"),
+				),
+			)),
+			wantErr: []string{
+				"firewall.0.expression.0: source_network is invalid",
+				"destination_port is invalid",
+			},
+		},
+		{
+			msg: "duplicated interface and direction",
+			in: vpcRouterFirewallRuleSets(testVPCRouterFirewallConfig(
+				testVPCRouterFirewallValue(1, "receive", testFirewallExpressionValue("ip", "", "", "")),
+				testVPCRouterFirewallValue(1, "receive", testFirewallExpressionValue("ip", "", "", "")),
+			)),
+			wantErr: []string{"firewall.1: interface_index=1, direction=receive is already declared in firewall.0"},
+		},
+		{
+			msg: "unknown values are skipped",
+			in: packetFilterRuleSets(cty.ObjectVal(map[string]cty.Value{
+				"expression": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"protocol":         cty.StringVal("icmp"),
+						"source_network":   cty.UnknownVal(cty.String),
+						"source_port":      cty.StringVal(""),
+						"destination_port": cty.StringVal("22"),
+						"allow":            cty.True,
+					}),
+				}),
+			})),
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateFirewallRuleSets(tc.in)
+		if len(tc.wantErr) == 0 {
+			require.NoError(t, err, tc.msg)
+			continue
+		}
+		require.Error(t, err, tc.msg)
+		for _, want := range tc.wantErr {
+			require.Contains(t, err.Error(), want, tc.msg)
+		}
+	}
+}
+
+func TestShadowedFirewallRuleWarnings(t *testing.T) {
+	config := testVPCRouterFirewallConfig(
+		testVPCRouterFirewallValue(0, "receive",
+			testFirewallExpressionValue("tcp", "192.0.2.0/24", "", "1024-2048"),
+			testFirewallExpressionValue("tcp", "192.0.2.1", "", "1080"),
+			testFirewallExpressionValue("tcp", "", "", "1080"),
+			testFirewallExpressionValue("ip", "", "", ""),
+			testFirewallExpressionValue("udp", "", "", "53"),
+		),
+		testVPCRouterFirewallValue(0, "send",
+			testFirewallExpressionValue("udp", "", "", "53"),
+		),
+	)
+
+	diags := shadowedFirewallRuleWarnings(vpcRouterFirewallRuleSets(config))
+	require.Len(t, diags, 2)
+	for _, d := range diags {
+		require.Equal(t, diag.Warning, d.Severity)
+	}
+	require.Equal(t, "firewall.0.expression.1 can never match because firewall.0.expression.0 covers all packets matched by it", diags[0].Detail)
+	require.Equal(t, "firewall.0.expression.4 can never match because firewall.0.expression.3 covers all packets matched by it", diags[1].Detail)
+}
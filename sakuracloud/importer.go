@@ -0,0 +1,57 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// importZonalResourceStateContext ゾーンに属するリソース向けのインポート処理
+//
+// インポートIDとして`<zone>/<id>`形式を受け付け、zoneをstateに設定する。
+// ゾーンが省略された場合はプロバイダーのデフォルトゾーンが利用される。
+func importZonalResourceStateContext(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zone, id, err := parseZonalImportID(d.Id(), meta.(*APIClient).zones)
+	if err != nil {
+		return nil, err
+	}
+	if zone != "" {
+		if err := d.Set("zone", zone); err != nil {
+			return nil, err
+		}
+	}
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseZonalImportID(importID string, zones []string) (string, string, error) {
+	if !strings.Contains(importID, "/") {
+		return "", importID, nil
+	}
+
+	parts := strings.Split(importID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid import id %q: expected format is <zone>/<id> or <id>", importID)
+	}
+	if _, errs := validation.StringInSlice(zones, false)(parts[0], "zone"); len(errs) > 0 {
+		return "", "", fmt.Errorf("invalid import id %q: %s", importID, errs[0])
+	}
+	return parts[0], parts[1], nil
+}
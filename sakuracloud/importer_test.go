@@ -0,0 +1,52 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseZonalImportID(t *testing.T) {
+	zones := []string{"is1a", "tk1b"}
+
+	cases := []struct {
+		in       string
+		wantZone string
+		wantID   string
+		wantErr  bool
+	}{
+		{in: "123456789012", wantZone: "", wantID: "123456789012"},
+		{in: "tk1b/123456789012", wantZone: "tk1b", wantID: "123456789012"},
+		{in: "tk1a/123456789012", wantErr: true},
+		{in: "/123456789012", wantErr: true},
+		{in: "tk1b/", wantErr: true},
+		{in: "tk1b/123/456", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			zone, id, err := parseZonalImportID(tc.in, zones)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantZone, zone)
+			require.Equal(t, tc.wantID, id)
+		})
+	}
+}
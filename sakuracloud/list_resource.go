@@ -0,0 +1,438 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = (*iaasListResource)(nil)
+	_ list.ListResourceWithRawV5Schemas = (*iaasListResource)(nil)
+)
+
+type iaasFindResult interface {
+	Values() []interface{}
+}
+
+type iaasZonalFindAPI[T iaasFindResult] interface {
+	Find(ctx context.Context, zone string, conditions *iaas.FindCondition) (T, error)
+}
+
+type iaasGlobalFindAPI[T iaasFindResult] interface {
+	Find(ctx context.Context, conditions *iaas.FindCondition) (T, error)
+}
+
+type iaasListFindFunc func(ctx context.Context, client *APIClient, zone string, conditions *iaas.FindCondition) ([]interface{}, error)
+
+func zonalListFinder[API iaasZonalFindAPI[T], T iaasFindResult](newOp func(iaas.APICaller) API) iaasListFindFunc {
+	return func(ctx context.Context, client *APIClient, zone string, conditions *iaas.FindCondition) ([]interface{}, error) {
+		res, err := newOp(client).Find(ctx, zone, conditions)
+		if err != nil {
+			return nil, err
+		}
+		return res.Values(), nil
+	}
+}
+
+func globalListFinder[API iaasGlobalFindAPI[T], T iaasFindResult](newOp func(iaas.APICaller) API) iaasListFindFunc {
+	return func(ctx context.Context, client *APIClient, _ string, conditions *iaas.FindCondition) ([]interface{}, error) {
+		res, err := newOp(client).Find(ctx, conditions)
+		if err != nil {
+			return nil, err
+		}
+		return res.Values(), nil
+	}
+}
+
+type iaasListResourceDefinition struct {
+	zonal     bool
+	filterOpt *filterSchemaOption
+	find      iaasListFindFunc
+}
+
+// iaasListResourceDefinitions terraform queryで列挙可能なリソースの定義(キーはプロバイダー名を除いたリソース名)
+var iaasListResourceDefinitions = map[string]iaasListResourceDefinition{
+	"archive":        {zonal: true, find: zonalListFinder(iaas.NewArchiveOp)},
+	"bridge":         {zonal: true, filterOpt: &filterSchemaOption{excludeTags: true}, find: zonalListFinder(iaas.NewBridgeOp)},
+	"cdrom":          {zonal: true, find: zonalListFinder(iaas.NewCDROMOp)},
+	"database":       {zonal: true, find: zonalListFinder(iaas.NewDatabaseOp)},
+	"disk":           {zonal: true, find: zonalListFinder(iaas.NewDiskOp)},
+	"dns":            {find: globalListFinder(iaas.NewDNSOp)},
+	"gslb":           {find: globalListFinder(iaas.NewGSLBOp)},
+	"icon":           {find: globalListFinder(iaas.NewIconOp)},
+	"internet":       {zonal: true, find: zonalListFinder(iaas.NewInternetOp)},
+	"load_balancer":  {zonal: true, find: zonalListFinder(iaas.NewLoadBalancerOp)},
+	"nfs":            {zonal: true, find: zonalListFinder(iaas.NewNFSOp)},
+	"note":           {find: globalListFinder(iaas.NewNoteOp)},
+	"packet_filter":  {zonal: true, filterOpt: &filterSchemaOption{excludeTags: true}, find: zonalListFinder(iaas.NewPacketFilterOp)},
+	"private_host":   {zonal: true, find: zonalListFinder(iaas.NewPrivateHostOp)},
+	"proxylb":        {find: globalListFinder(iaas.NewProxyLBOp)},
+	"server":         {zonal: true, find: zonalListFinder(iaas.NewServerOp)},
+	"simple_monitor": {find: globalListFinder(iaas.NewSimpleMonitorOp)},
+	"ssh_key":        {filterOpt: &filterSchemaOption{excludeTags: true}, find: globalListFinder(iaas.NewSSHKeyOp)},
+	"switch":         {zonal: true, find: zonalListFinder(iaas.NewSwitchOp)},
+	"vpc_router":     {zonal: true, find: zonalListFinder(iaas.NewVPCRouterOp)},
+}
+
+// applyResourceIdentities terraform queryで列挙可能なリソースにidentityを付与する
+func applyResourceIdentities(resources map[string]*schema.Resource) {
+	for name, def := range iaasListResourceDefinitions {
+		withResourceIdentity(resources["sakuracloud_"+name], def.zonal)
+	}
+}
+
+// withResourceIdentity リソースにidentity(id、ゾーンに属するリソースの場合はzoneも)を付与する
+//
+// Read後にidentityを設定し、インポート時にはIDの代わりにidentityを受け付けるようにする。
+func withResourceIdentity(r *schema.Resource, zonal bool) {
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := map[string]*schema.Schema{
+				"id": {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       "The id of the resource",
+				},
+			}
+			if zonal {
+				s["zone"] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       "The name of zone that the resource belongs to",
+				}
+			}
+			return s
+		},
+	}
+
+	read := r.ReadContext
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := read(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, diag.FromErr(setResourceIdentity(d, zonal))...)
+	}
+
+	importer := r.Importer.StateContext
+	r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			identity, err := d.Identity()
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(identity.Get("id").(string))
+			if zonal {
+				if zone, ok := identity.GetOk("zone"); ok {
+					d.SetId(fmt.Sprintf("%s/%s", zone, d.Id()))
+				}
+			}
+		}
+		return importer(ctx, d, meta)
+	}
+}
+
+func setResourceIdentity(d *schema.ResourceData, zonal bool) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	if err := identity.Set("id", d.Id()); err != nil {
+		return err
+	}
+	if zonal {
+		return identity.Set("zone", d.Get("zone").(string))
+	}
+	return nil
+}
+
+// iaasListResource SDKv2で実装されたリソースをterraform queryで列挙するためのlist resource
+type iaasListResource struct {
+	typeName string
+	resource *schema.Resource
+	def      iaasListResourceDefinition
+	client   *APIClient
+}
+
+func newIAASListResources(primary *schema.Provider) []func() list.ListResource {
+	var names []string
+	for name := range iaasListResourceDefinitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var results []func() list.ListResource
+	for _, name := range names {
+		typeName := "sakuracloud_" + name
+		results = append(results, func() list.ListResource {
+			return &iaasListResource{
+				typeName: typeName,
+				resource: primary.ResourcesMap[typeName],
+				def:      iaasListResourceDefinitions[name],
+			}
+		})
+	}
+	return results
+}
+
+func (l *iaasListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = l.typeName
+}
+
+func (l *iaasListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	l.client = frameworkAPIClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (l *iaasListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	resp.ProtoV5Schema = l.resource.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = l.resource.ProtoIdentitySchema(ctx)()
+}
+
+func (l *iaasListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	filter, err := listResourceBlock(filterSchema(l.def.filterOpt))
+	if err != nil {
+		resp.Diagnostics.AddError("List resource schema conversion failed", err.Error())
+		return
+	}
+	resp.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{},
+		Blocks: map[string]listschema.Block{
+			filterAttrName: filter,
+		},
+	}
+	if l.def.zonal {
+		resp.Schema.Attributes["zone"] = listschema.StringAttribute{
+			Optional:    true,
+			Description: "The name of zone to list resources. Without this parameter, the zone of the provider is used",
+		}
+	}
+}
+
+func (l *iaasListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	if l.client == nil {
+		stream.Results = listResultsError("Provider not configured", fmt.Sprintf("The provider must be configured before listing %s", l.typeName))
+		return
+	}
+
+	zone := l.client.defaultZone
+	if l.def.zonal {
+		var v types.String
+		if diags := req.Config.GetAttribute(ctx, path.Root("zone"), &v); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+		if !v.IsNull() && v.ValueString() != "" {
+			zone = v.ValueString()
+		}
+		if !slices.Contains(l.client.zones, zone) {
+			stream.Results = listResultsError("Invalid zone", fmt.Sprintf("zone must be one of %s, got: %s", l.client.zones, zone))
+			return
+		}
+	}
+
+	var filters types.List
+	if diags := req.Config.GetAttribute(ctx, path.Root(filterAttrName), &filters); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	condition := &iaas.FindCondition{}
+	if !filters.IsNull() && len(filters.Elements()) > 0 {
+		if len(filters.Elements()) > 1 {
+			stream.Results = listResultsError("Invalid filter", "At most one filter block can be specified")
+			return
+		}
+		rawFilters := expandFrameworkValue(filters).([]interface{})
+		// list resourceのスキーマはSetAttributeをサポートしないため、SDKv2と同様に*schema.Setへ変換しておく
+		filter := rawFilters[0].(map[string]interface{})
+		if tags, ok := filter["tags"].([]interface{}); ok {
+			filter["tags"] = schema.NewSet(schema.HashString, tags)
+		}
+		if err := validateListFilterOperators(rawFilters); err != nil {
+			stream.Results = listResultsError("Invalid filter", err.Error())
+			return
+		}
+		condition.Filter = expandSearchFilter(rawFilters)
+	}
+
+	values, err := l.def.find(ctx, l.client, zone, condition)
+	if err != nil {
+		stream.Results = listResultsError("List failed", fmt.Sprintf("could not find SakuraCloud resources for %s: %s", l.typeName, err))
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, v := range values {
+			target, ok := v.(interface {
+				GetID() iaastypes.ID
+				GetName() string
+			})
+			if !ok {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = target.GetName()
+			l.setListResult(ctx, req, &result, target.GetID().String(), zone)
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func (l *iaasListResource) setListResult(ctx context.Context, req list.ListRequest, result *list.ListResult, id, zone string) {
+	d := l.resource.Data(nil)
+	d.SetId(id)
+	if l.def.zonal {
+		d.Set("zone", zone) //nolint:errcheck,gosec
+	}
+
+	if req.IncludeResource {
+		diags := l.resource.ReadContext(ctx, d, l.client)
+		if diags.HasError() {
+			for _, d := range diags {
+				result.Diagnostics.AddError(d.Summary, d.Detail)
+			}
+			return
+		}
+	} else if err := setResourceIdentity(d, l.def.zonal); err != nil {
+		result.Diagnostics.AddError("Setting identity failed", err.Error())
+		return
+	}
+
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Converting identity failed", err.Error())
+		return
+	}
+	result.Identity.Raw = identity.Copy()
+
+	if req.IncludeResource {
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Converting resource state failed", err.Error())
+			return
+		}
+		result.Resource.Raw = state.Copy()
+	}
+}
+
+func listResultsError(summary, detail string) func(push func(list.ListResult) bool) {
+	var diags fwdiag.Diagnostics
+	diags.AddError(summary, detail)
+	return list.ListResultsStreamDiagnostics(diags)
+}
+
+func validateListFilterOperators(rawFilters []interface{}) error {
+	for _, rawFilter := range rawFilters {
+		conditions, _ := rawFilter.(map[string]interface{})["condition"].([]interface{})
+		for _, rawCondition := range conditions {
+			operator := rawCondition.(map[string]interface{})["operator"].(string)
+			if operator != "" && !slices.Contains(filteringOperators, operator) {
+				return fmt.Errorf("operator must be one of %s, got: %s", filteringOperators, operator)
+			}
+		}
+	}
+	return nil
+}
+
+// expandFrameworkValue frameworkの値をSDKv2のResourceData.Get()と同じ形式に変換する
+//
+// expandSearchFilterなどSDKv2向けのexpand関数をlist resourceから利用するために用いる。
+func expandFrameworkValue(v attr.Value) interface{} {
+	switch v := v.(type) {
+	case types.String:
+		return v.ValueString()
+	case types.List:
+		results := []interface{}{}
+		for _, e := range v.Elements() {
+			results = append(results, expandFrameworkValue(e))
+		}
+		return results
+	case types.Object:
+		results := map[string]interface{}{}
+		for k, e := range v.Attributes() {
+			results[k] = expandFrameworkValue(e)
+		}
+		return results
+	default:
+		return nil
+	}
+}
+
+// listResourceBlock SDKv2のスキーマ(filterSchemaなど)からlist resource向けのブロックを生成する
+func listResourceBlock(s *schema.Schema) (listschema.ListNestedBlock, error) {
+	r, ok := s.Elem.(*schema.Resource)
+	if !ok {
+		return listschema.ListNestedBlock{}, fmt.Errorf("unsupported element type %T", s.Elem)
+	}
+
+	object := listschema.NestedBlockObject{
+		Attributes: map[string]listschema.Attribute{},
+		Blocks:     map[string]listschema.Block{},
+	}
+	for name, child := range r.Schema {
+		switch child.Type {
+		case schema.TypeString:
+			object.Attributes[name] = listschema.StringAttribute{
+				Optional:    child.Optional,
+				Required:    child.Required,
+				Description: child.Description,
+			}
+		case schema.TypeList, schema.TypeSet:
+			if _, ok := child.Elem.(*schema.Resource); ok {
+				block, err := listResourceBlock(child)
+				if err != nil {
+					return listschema.ListNestedBlock{}, fmt.Errorf("%s: %s", name, err)
+				}
+				object.Blocks[name] = block
+				continue
+			}
+			elem, ok := child.Elem.(*schema.Schema)
+			if !ok || elem.Type != schema.TypeString {
+				return listschema.ListNestedBlock{}, fmt.Errorf("%s: only string element is supported", name)
+			}
+			object.Attributes[name] = listschema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    child.Optional,
+				Required:    child.Required,
+				Description: child.Description,
+			}
+		default:
+			return listschema.ListNestedBlock{}, fmt.Errorf("%s: unsupported type %s", name, child.Type)
+		}
+	}
+
+	return listschema.ListNestedBlock{
+		NestedObject: object,
+		Description:  s.Description,
+	}, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/sacloud/iaas-api-go/search"
+	"github.com/sacloud/iaas-api-go/search/keys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIAASListResources_schemas(t *testing.T) {
+	serverFactory, err := ProtoV5ProviderServerFactory(context.Background())
+	require.NoError(t, err)
+	server := serverFactory()
+
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	identityResp, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov5.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+
+	for name, def := range iaasListResourceDefinitions {
+		typeName := "sakuracloud_" + name
+		require.Contains(t, schemaResp.ListResourceSchemas, typeName)
+		require.Contains(t, identityResp.IdentitySchemas, typeName)
+		require.Len(t, identityResp.IdentitySchemas[typeName].IdentityAttributes, map[bool]int{true: 2, false: 1}[def.zonal], typeName)
+	}
+}
+
+func TestExpandFrameworkValue_searchFilter(t *testing.T) {
+	conditionType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":     types.StringType,
+		"values":   types.ListType{ElemType: types.StringType},
+		"operator": types.StringType,
+	}}
+	filterType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":        types.StringType,
+		"names":     types.ListType{ElemType: types.StringType},
+		"condition": types.ListType{ElemType: conditionType},
+	}}
+
+	filters := types.ListValueMust(filterType, []attr.Value{
+		types.ObjectValueMust(filterType.AttrTypes, map[string]attr.Value{
+			"id":    types.StringNull(),
+			"names": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("foo")}),
+			"condition": types.ListValueMust(conditionType, []attr.Value{
+				types.ObjectValueMust(conditionType.AttrTypes, map[string]attr.Value{
+					"name":     types.StringValue("Class"),
+					"values":   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("shared")}),
+					"operator": types.StringValue(filteringOperatorExactMatchOr),
+				}),
+			}),
+		}),
+	})
+
+	rawFilters := expandFrameworkValue(filters).([]interface{})
+	require.NoError(t, validateListFilterOperators(rawFilters))
+	require.Equal(t, search.Filter{
+		search.Key(keys.Name): search.AndEqual("foo"),
+		search.Key("Class"):   search.OrEqual("shared"),
+	}, expandSearchFilter(rawFilters))
+}
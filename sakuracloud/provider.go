@@ -136,91 +136,109 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"sakuracloud_apprun_application":    dataSourceSakuraCloudApprunApplication(),
-			"sakuracloud_archive":               dataSourceSakuraCloudArchive(),
-			"sakuracloud_auto_scale":            dataSourceSakuraCloudAutoScale(),
-			"sakuracloud_bridge":                dataSourceSakuraCloudBridge(),
-			"sakuracloud_cdrom":                 dataSourceSakuraCloudCDROM(),
-			"sakuracloud_certificate_authority": dataSourceSakuraCloudCertificateAuthority(),
-			"sakuracloud_container_registry":    dataSourceSakuraCloudContainerRegistry(),
-			"sakuracloud_database":              dataSourceSakuraCloudDatabase(),
-			"sakuracloud_disk":                  dataSourceSakuraCloudDisk(),
-			"sakuracloud_dns":                   dataSourceSakuraCloudDNS(),
-			"sakuracloud_enhanced_db":           dataSourceSakuraCloudEnhancedDB(),
-			"sakuracloud_esme":                  dataSourceSakuraCloudESME(),
-			"sakuracloud_gslb":                  dataSourceSakuraCloudGSLB(),
-			"sakuracloud_icon":                  dataSourceSakuraCloudIcon(),
-			"sakuracloud_internet":              dataSourceSakuraCloudInternet(),
-			"sakuracloud_kms":                   dataSourceSakuraCloudKMS(),
-			"sakuracloud_load_balancer":         dataSourceSakuraCloudLoadBalancer(),
-			"sakuracloud_local_router":          dataSourceSakuraCloudLocalRouter(),
-			"sakuracloud_note":                  dataSourceSakuraCloudNote(),
-			"sakuracloud_nfs":                   dataSourceSakuraCloudNFS(),
-			"sakuracloud_packet_filter":         dataSourceSakuraCloudPacketFilter(),
-			"sakuracloud_proxylb":               dataSourceSakuraCloudProxyLB(),
-			"sakuracloud_private_host":          dataSourceSakuraCloudPrivateHost(),
-			"sakuracloud_secret_manager":        dataSourceSakuraCloudSecretManager(),
-			"sakuracloud_secret_manager_secret": dataSourceSakuraCloudSecretManagerSecret(),
-			"sakuracloud_simple_monitor":        dataSourceSakuraCloudSimpleMonitor(),
-			"sakuracloud_simple_mq":             dataSourceSakuraCloudSimpleMQ(),
-			"sakuracloud_server":                dataSourceSakuraCloudServer(),
-			"sakuracloud_server_vnc_info":       dataSourceSakuraCloudServerVNCInfo(),
-			"sakuracloud_ssh_key":               dataSourceSakuraCloudSSHKey(),
-			"sakuracloud_subnet":                dataSourceSakuraCloudSubnet(),
-			"sakuracloud_switch":                dataSourceSakuraCloudSwitch(),
-			"sakuracloud_vpc_router":            dataSourceSakuraCloudVPCRouter(),
-			"sakuracloud_webaccel":              dataSourceSakuraCloudWebAccel(),
-			"sakuracloud_zone":                  dataSourceSakuraCloudZone(),
+			"sakuracloud_apprun_application":         dataSourceSakuraCloudApprunApplication(),
+			"sakuracloud_archive":                    dataSourceSakuraCloudArchive(),
+			"sakuracloud_auto_scale":                 dataSourceSakuraCloudAutoScale(),
+			"sakuracloud_bridge":                     dataSourceSakuraCloudBridge(),
+			"sakuracloud_cdrom":                      dataSourceSakuraCloudCDROM(),
+			"sakuracloud_certificate_authority":      dataSourceSakuraCloudCertificateAuthority(),
+			"sakuracloud_container_registry":         dataSourceSakuraCloudContainerRegistry(),
+			"sakuracloud_database":                   dataSourceSakuraCloudDatabase(),
+			"sakuracloud_database_backups":           dataSourceSakuraCloudDatabaseBackups(),
+			"sakuracloud_disk":                       dataSourceSakuraCloudDisk(),
+			"sakuracloud_dns":                        dataSourceSakuraCloudDNS(),
+			"sakuracloud_enhanced_db":                dataSourceSakuraCloudEnhancedDB(),
+			"sakuracloud_esme":                       dataSourceSakuraCloudESME(),
+			"sakuracloud_gslb":                       dataSourceSakuraCloudGSLB(),
+			"sakuracloud_icon":                       dataSourceSakuraCloudIcon(),
+			"sakuracloud_internet":                   dataSourceSakuraCloudInternet(),
+			"sakuracloud_kms":                        dataSourceSakuraCloudKMS(),
+			"sakuracloud_load_balancer":              dataSourceSakuraCloudLoadBalancer(),
+			"sakuracloud_local_router":               dataSourceSakuraCloudLocalRouter(),
+			"sakuracloud_note":                       dataSourceSakuraCloudNote(),
+			"sakuracloud_nfs":                        dataSourceSakuraCloudNFS(),
+			"sakuracloud_packet_filter":              dataSourceSakuraCloudPacketFilter(),
+			"sakuracloud_proxylb":                    dataSourceSakuraCloudProxyLB(),
+			"sakuracloud_proxylb_status":             dataSourceSakuraCloudProxyLBStatus(),
+			"sakuracloud_private_host":               dataSourceSakuraCloudPrivateHost(),
+			"sakuracloud_secret_manager":             dataSourceSakuraCloudSecretManager(),
+			"sakuracloud_secret_manager_secret":      dataSourceSakuraCloudSecretManagerSecret(),
+			"sakuracloud_simple_monitor":             dataSourceSakuraCloudSimpleMonitor(),
+			"sakuracloud_simple_mq":                  dataSourceSakuraCloudSimpleMQ(),
+			"sakuracloud_server":                     dataSourceSakuraCloudServer(),
+			"sakuracloud_server_vnc_info":            dataSourceSakuraCloudServerVNCInfo(),
+			"sakuracloud_ssh_key":                    dataSourceSakuraCloudSSHKey(),
+			"sakuracloud_subnet":                     dataSourceSakuraCloudSubnet(),
+			"sakuracloud_switch":                     dataSourceSakuraCloudSwitch(),
+			"sakuracloud_vpc_router":                 dataSourceSakuraCloudVPCRouter(),
+			"sakuracloud_vpc_router_status":          dataSourceSakuraCloudVPCRouterStatus(),
+			"sakuracloud_vpc_router_vpn_peer_config": dataSourceSakuraCloudVPCRouterVPNPeerConfig(),
+			"sakuracloud_webaccel":                   dataSourceSakuraCloudWebAccel(),
+			"sakuracloud_zone":                       dataSourceSakuraCloudZone(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"sakuracloud_apprun_application":    resourceSakuraCloudApprunApplication(),
-			"sakuracloud_auto_backup":           resourceSakuraCloudAutoBackup(),
-			"sakuracloud_auto_scale":            resourceSakuraCloudAutoScale(),
-			"sakuracloud_archive":               resourceSakuraCloudArchive(),
-			"sakuracloud_archive_share":         resourceSakuraCloudArchiveShare(),
-			"sakuracloud_bridge":                resourceSakuraCloudBridge(),
-			"sakuracloud_cdrom":                 resourceSakuraCloudCDROM(),
-			"sakuracloud_certificate_authority": resourceSakuraCloudCertificateAuthority(),
-			"sakuracloud_container_registry":    resourceSakuraCloudContainerRegistry(),
-			"sakuracloud_database":              resourceSakuraCloudDatabase(),
-			"sakuracloud_database_read_replica": resourceSakuraCloudDatabaseReadReplica(),
-			"sakuracloud_disk":                  resourceSakuraCloudDisk(),
-			"sakuracloud_dns":                   resourceSakuraCloudDNS(),
-			"sakuracloud_dns_record":            resourceSakuraCloudDNSRecord(),
-			"sakuracloud_enhanced_db":           resourceSakuraCloudEnhancedDB(),
-			"sakuracloud_esme":                  resourceSakuraCloudESME(),
-			"sakuracloud_gslb":                  resourceSakuraCloudGSLB(),
-			"sakuracloud_icon":                  resourceSakuraCloudIcon(),
-			"sakuracloud_internet":              resourceSakuraCloudInternet(),
-			"sakuracloud_ipv4_ptr":              resourceSakuraCloudIPv4Ptr(),
-			"sakuracloud_kms":                   resourceSakuraCloudKMS(),
-			"sakuracloud_load_balancer":         resourceSakuraCloudLoadBalancer(),
-			"sakuracloud_local_router":          resourceSakuraCloudLocalRouter(),
-			"sakuracloud_mobile_gateway":        resourceSakuraCloudMobileGateway(),
-			"sakuracloud_note":                  resourceSakuraCloudNote(),
-			"sakuracloud_nfs":                   resourceSakuraCloudNFS(),
-			"sakuracloud_packet_filter":         resourceSakuraCloudPacketFilter(),
-			"sakuracloud_packet_filter_rules":   resourceSakuraCloudPacketFilterRules(),
-			"sakuracloud_proxylb":               resourceSakuraCloudProxyLB(),
-			"sakuracloud_proxylb_acme":          resourceSakuraCloudProxyLBACME(),
-			"sakuracloud_private_host":          resourceSakuraCloudPrivateHost(),
-			"sakuracloud_secret_manager":        resourceSakuraCloudSecretManager(),
-			"sakuracloud_secret_manager_secret": resourceSakuraCloudSecretManagerSecret(),
-			"sakuracloud_sim":                   resourceSakuraCloudSIM(),
-			"sakuracloud_simple_monitor":        resourceSakuraCloudSimpleMonitor(),
-			"sakuracloud_simple_mq":             resourceSakuraCloudSimpleMQ(),
-			"sakuracloud_server":                resourceSakuraCloudServer(),
-			"sakuracloud_ssh_key":               resourceSakuraCloudSSHKey(),
-			"sakuracloud_subnet":                resourceSakuraCloudSubnet(),
-			"sakuracloud_switch":                resourceSakuraCloudSwitch(),
-			"sakuracloud_vpc_router":            resourceSakuraCloudVPCRouter(),
-			"sakuracloud_webaccel":              resourceSakuraCloudWebAccel(),
-			"sakuracloud_webaccel_activation":   resourceSakuraCloudWebAccelActivation(),
-			"sakuracloud_webaccel_acl":          resourceSakuraCloudWebAccelACL(),
-			"sakuracloud_webaccel_certificate":  resourceSakuraCloudWebAccelCertificate(),
+			"sakuracloud_apprun_application":             resourceSakuraCloudApprunApplication(),
+			"sakuracloud_auto_backup":                    resourceSakuraCloudAutoBackup(),
+			"sakuracloud_auto_scale":                     resourceSakuraCloudAutoScale(),
+			"sakuracloud_archive":                        resourceSakuraCloudArchive(),
+			"sakuracloud_archive_share":                  resourceSakuraCloudArchiveShare(),
+			"sakuracloud_bridge":                         resourceSakuraCloudBridge(),
+			"sakuracloud_cdrom":                          resourceSakuraCloudCDROM(),
+			"sakuracloud_certificate_authority":          resourceSakuraCloudCertificateAuthority(),
+			"sakuracloud_container_registry":             resourceSakuraCloudContainerRegistry(),
+			"sakuracloud_database":                       resourceSakuraCloudDatabase(),
+			"sakuracloud_database_backup":                resourceSakuraCloudDatabaseBackup(),
+			"sakuracloud_database_grant":                 resourceSakuraCloudDatabaseGrant(),
+			"sakuracloud_database_read_replica":          resourceSakuraCloudDatabaseReadReplica(),
+			"sakuracloud_database_schema":                resourceSakuraCloudDatabaseSchema(),
+			"sakuracloud_database_user":                  resourceSakuraCloudDatabaseUser(),
+			"sakuracloud_disk":                           resourceSakuraCloudDisk(),
+			"sakuracloud_dns":                            resourceSakuraCloudDNS(),
+			"sakuracloud_dns_record":                     resourceSakuraCloudDNSRecord(),
+			"sakuracloud_enhanced_db":                    resourceSakuraCloudEnhancedDB(),
+			"sakuracloud_esme":                           resourceSakuraCloudESME(),
+			"sakuracloud_gslb":                           resourceSakuraCloudGSLB(),
+			"sakuracloud_icon":                           resourceSakuraCloudIcon(),
+			"sakuracloud_internet":                       resourceSakuraCloudInternet(),
+			"sakuracloud_ipv4_ptr":                       resourceSakuraCloudIPv4Ptr(),
+			"sakuracloud_kms":                            resourceSakuraCloudKMS(),
+			"sakuracloud_load_balancer":                  resourceSakuraCloudLoadBalancer(),
+			"sakuracloud_local_router":                   resourceSakuraCloudLocalRouter(),
+			"sakuracloud_mobile_gateway":                 resourceSakuraCloudMobileGateway(),
+			"sakuracloud_note":                           resourceSakuraCloudNote(),
+			"sakuracloud_nfs":                            resourceSakuraCloudNFS(),
+			"sakuracloud_packet_filter":                  resourceSakuraCloudPacketFilter(),
+			"sakuracloud_packet_filter_rules":            resourceSakuraCloudPacketFilterRules(),
+			"sakuracloud_proxylb":                        resourceSakuraCloudProxyLB(),
+			"sakuracloud_proxylb_acme":                   resourceSakuraCloudProxyLBACME(),
+			"sakuracloud_proxylb_certificate":            resourceSakuraCloudProxyLBCertificate(),
+			"sakuracloud_proxylb_rule":                   resourceSakuraCloudProxyLBRule(),
+			"sakuracloud_proxylb_server":                 resourceSakuraCloudProxyLBServer(),
+			"sakuracloud_private_host":                   resourceSakuraCloudPrivateHost(),
+			"sakuracloud_secret_manager":                 resourceSakuraCloudSecretManager(),
+			"sakuracloud_secret_manager_secret":          resourceSakuraCloudSecretManagerSecret(),
+			"sakuracloud_sim":                            resourceSakuraCloudSIM(),
+			"sakuracloud_simple_monitor":                 resourceSakuraCloudSimpleMonitor(),
+			"sakuracloud_simple_mq":                      resourceSakuraCloudSimpleMQ(),
+			"sakuracloud_server":                         resourceSakuraCloudServer(),
+			"sakuracloud_ssh_key":                        resourceSakuraCloudSSHKey(),
+			"sakuracloud_subnet":                         resourceSakuraCloudSubnet(),
+			"sakuracloud_switch":                         resourceSakuraCloudSwitch(),
+			"sakuracloud_vpc_router":                     resourceSakuraCloudVPCRouter(),
+			"sakuracloud_vpc_router_dhcp_static_mapping": resourceSakuraCloudVPCRouterDHCPStaticMapping(),
+			"sakuracloud_vpc_router_firewall":            resourceSakuraCloudVPCRouterFirewall(),
+			"sakuracloud_vpc_router_port_forwarding":     resourceSakuraCloudVPCRouterPortForwarding(),
+			"sakuracloud_vpc_router_static_nat":          resourceSakuraCloudVPCRouterStaticNAT(),
+			"sakuracloud_vpc_router_static_route":        resourceSakuraCloudVPCRouterStaticRoute(),
+			"sakuracloud_webaccel":                       resourceSakuraCloudWebAccel(),
+			"sakuracloud_webaccel_activation":            resourceSakuraCloudWebAccelActivation(),
+			"sakuracloud_webaccel_acl":                   resourceSakuraCloudWebAccelACL(),
+			"sakuracloud_webaccel_certificate":           resourceSakuraCloudWebAccelCertificate(),
 		},
 	}
 
+	applyResourceIdentities(provider.ResourcesMap)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -0,0 +1,176 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	_ provider.Provider                       = (*frameworkProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*frameworkProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*frameworkProvider)(nil)
+	_ provider.ProviderWithListResources      = (*frameworkProvider)(nil)
+)
+
+// frameworkProvider terraform-plugin-frameworkで実装するリソース/データソース向けのプロバイダー
+//
+// SDKv2で実装されたプロバイダー(primary)とterraform-plugin-muxで束ねて利用する。
+// プロバイダーのスキーマとAPIClientはprimaryのものを共有する。
+type frameworkProvider struct {
+	primary *schema.Provider
+}
+
+// NewFrameworkProvider returns a terraform-plugin-framework provider which shares its schema and API client with primary
+func NewFrameworkProvider(primary *schema.Provider) provider.Provider {
+	return &frameworkProvider{primary: primary}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "sakuracloud"
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	s, err := frameworkProviderSchema(p.primary.Schema)
+	if err != nil {
+		resp.Diagnostics.AddError("Provider schema conversion failed", err.Error())
+		return
+	}
+	resp.Schema = s
+}
+
+// Configure muxで先に設定されたprimary(SDKv2)プロバイダーのAPIClientを引き継ぐ
+//
+// tf5muxserverはサーバの登録順にConfigureProviderを呼び出すため、
+// primaryはframeworkProviderより前に登録しておく必要がある。
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	client, ok := p.primary.Meta().(*APIClient)
+	if !ok || client == nil {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The SDKv2 provider must be configured before the plugin-framework provider. This is a bug in the provider, please report it.",
+		)
+		return
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		newDataSourceKMSCiphertext,
+		newDataSourceKMSPlaintext,
+	}
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newEphemeralKMSPlaintext,
+		newEphemeralSecretManagerSecret,
+	}
+}
+
+func (p *frameworkProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return newIAASListResources(p.primary)
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{}
+}
+
+// frameworkProviderSchema SDKv2のプロバイダースキーマからframework向けのスキーマを生成する
+//
+// muxで束ねる各プロバイダーのスキーマは完全に一致している必要があるため、
+// 定義を二重に持たずSDKv2側の定義から変換する。
+func frameworkProviderSchema(sdkSchema map[string]*schema.Schema) (fwschema.Schema, error) {
+	attrs := make(map[string]fwschema.Attribute, len(sdkSchema))
+	for name, s := range sdkSchema {
+		switch s.Type {
+		case schema.TypeString:
+			attrs[name] = fwschema.StringAttribute{
+				Optional:    s.Optional,
+				Required:    s.Required,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		case schema.TypeInt:
+			attrs[name] = fwschema.Int64Attribute{
+				Optional:    s.Optional,
+				Required:    s.Required,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		case schema.TypeBool:
+			attrs[name] = fwschema.BoolAttribute{
+				Optional:    s.Optional,
+				Required:    s.Required,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		case schema.TypeList:
+			elem, ok := s.Elem.(*schema.Schema)
+			if !ok || elem.Type != schema.TypeString {
+				return fwschema.Schema{}, fmt.Errorf("provider schema %q: only list of string is supported", name)
+			}
+			attrs[name] = fwschema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    s.Optional,
+				Required:    s.Required,
+				Sensitive:   s.Sensitive,
+				Description: s.Description,
+			}
+		default:
+			return fwschema.Schema{}, fmt.Errorf("provider schema %q: unsupported type %s", name, s.Type)
+		}
+	}
+	return fwschema.Schema{Attributes: attrs}, nil
+}
+
+// frameworkAPIClient framework実装のリソース/データソースのConfigureに渡されるProviderDataからAPIClientを取り出す
+//
+// Terraformはプロバイダーの設定前にもConfigureを呼び出すため、その場合はnilを返す。
+func frameworkAPIClient(providerData any, diags *diag.Diagnostics) *APIClient {
+	if providerData == nil {
+		return nil
+	}
+	client, ok := providerData.(*APIClient)
+	if !ok {
+		diags.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *APIClient, got: %T. This is a bug in the provider, please report it.", providerData),
+		)
+		return nil
+	}
+	return client
+}
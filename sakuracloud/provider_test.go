@@ -47,7 +47,7 @@ func init() {
 	}
 	ProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
 		"sakuracloud": func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, err := ProtoV5ProviderServerFactory(context.Background())
+			providerServerFactory, err := protoV5ProviderServerFactory(context.Background(), testAccProvider)
 			if err != nil {
 				return nil, err
 			}
@@ -62,6 +62,24 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProtoV5ProviderServerFactory(t *testing.T) {
+	serverFactory, err := ProtoV5ProviderServerFactory(context.Background())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// muxで束ねた各プロバイダーのスキーマが一致していない場合はエラーになる
+	resp, err := serverFactory().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected diagnostics: %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
 func TestProviderSchema(t *testing.T) {
 	origEnv := os.Environ()
 	t.Cleanup(func() {
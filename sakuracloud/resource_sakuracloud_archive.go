@@ -39,7 +39,7 @@ func resourceSakuraCloudArchive() *schema.Resource {
 			return d.HasChange("archive_file")
 		}),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -31,6 +31,9 @@ func resourceSakuraCloudArchiveShare() *schema.Resource {
 		CreateContext: resourceSakuraCloudArchiveShareCreate,
 		ReadContext:   resourceSakuraCloudArchiveShareRead,
 		DeleteContext: resourceSakuraCloudArchiveShareDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importZonalResourceStateContext,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -106,6 +109,7 @@ func resourceSakuraCloudArchiveShareRead(ctx context.Context, d *schema.Resource
 	}
 
 	d.SetId(archive.ID.String())
+	d.Set("archive_id", archive.ID.String())        //nolint:errcheck,gosec
 	d.Set("share_key", d.Get("share_key").(string)) //nolint:errcheck,gosec
 	d.Set("zone", zone)                             //nolint:errcheck,gosec
 	return nil
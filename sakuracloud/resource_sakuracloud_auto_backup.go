@@ -34,7 +34,7 @@ func resourceSakuraCloudAutoBackup() *schema.Resource {
 		UpdateContext: resourceSakuraCloudAutoBackupUpdate,
 		DeleteContext: resourceSakuraCloudAutoBackupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
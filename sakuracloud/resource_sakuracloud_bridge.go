@@ -32,7 +32,7 @@ func resourceSakuraCloudBridge() *schema.Resource {
 		UpdateContext: resourceSakuraCloudBridgeUpdate,
 		DeleteContext: resourceSakuraCloudBridgeDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
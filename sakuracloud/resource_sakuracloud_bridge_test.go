@@ -147,6 +147,19 @@ func TestAccImportSakuraCloudBridge_basic(t *testing.T) {
 				ImportStateCheck:  checkFn,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["zone"], rs.Primary.ID), nil
+				},
+				ImportStateCheck:  checkFn,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
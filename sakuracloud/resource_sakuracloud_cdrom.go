@@ -40,7 +40,7 @@ func resourceSakuraCloudCDROM() *schema.Resource {
 		UpdateContext: resourceSakuraCloudCDROMUpdate,
 		DeleteContext: resourceSakuraCloudCDROMDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 		CustomizeDiff: customdiff.ComputedIf("hash", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 			return d.HasChange("iso_image_file") || d.HasChange("content")
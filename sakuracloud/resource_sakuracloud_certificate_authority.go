@@ -34,6 +34,9 @@ func resourceSakuraCloudCertificateAuthority() *schema.Resource {
 		ReadContext:   resourceSakuraCloudCertificateAuthorityRead,
 		UpdateContext: resourceSakuraCloudCertificateAuthorityUpdate,
 		DeleteContext: resourceSakuraCloudCertificateAuthorityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSakuraCloudCertificateAuthorityImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -362,6 +365,31 @@ func resourceSakuraCloudCertificateAuthorityRead(ctx context.Context, d *schema.
 	return setCertificateAuthorityResourceData(ctx, d, client, ca)
 }
 
+// resourceSakuraCloudCertificateAuthorityImport clients/serversはstate上のIDを元にReadされるため、インポート時にAPIから組み立てておく
+func resourceSakuraCloudCertificateAuthorityImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, _, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	caSvc := caService.New(client)
+	ca, err := caSvc.ReadWithContext(ctx, &caService.ReadRequest{ID: sakuraCloudID(d.Id())})
+	if err != nil {
+		return nil, fmt.Errorf("could not read SakuraCloud CertificateAuthority[%s]: %s", d.Id(), err)
+	}
+
+	if ca.Detail != nil && ca.Detail.CertificateData != nil {
+		d.Set("validity_period_hours", flattenCertificateAuthorityValidityPeriodHours(ca.Detail.CertificateData)) //nolint:errcheck,gosec
+	}
+	if err := d.Set("client", flattenCertificateAuthorityClientsForImport(ca.Clients)); err != nil {
+		return nil, err
+	}
+	if err := d.Set("server", flattenCertificateAuthorityServersForImport(ca.Servers)); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceSakuraCloudCertificateAuthorityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, err := sakuraCloudClient(d, meta)
 	if err != nil {
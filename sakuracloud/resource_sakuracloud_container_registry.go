@@ -33,6 +33,9 @@ func resourceSakuraCloudContainerRegistry() *schema.Resource {
 		ReadContext:   resourceSakuraCloudContainerRegistryRead,
 		UpdateContext: resourceSakuraCloudContainerRegistryUpdate,
 		DeleteContext: resourceSakuraCloudContainerRegistryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -30,13 +30,15 @@ import (
 
 func resourceSakuraCloudDatabase() *schema.Resource {
 	resourceName := "Database"
+
 	return &schema.Resource{
 		CreateContext: resourceSakuraCloudDatabaseCreate,
 		ReadContext:   resourceSakuraCloudDatabaseRead,
 		UpdateContext: resourceSakuraCloudDatabaseUpdate,
 		DeleteContext: resourceSakuraCloudDatabaseDelete,
+		CustomizeDiff: resourceSakuraCloudDatabaseCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -208,6 +210,73 @@ func resourceSakuraCloudDatabase() *schema.Resource {
 					},
 				},
 			},
+			"restore_from": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The backup of the Database to restore the data from. The data is restored in place when this is added or `backup_id` is changed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+							Description:      "The id of the backup of the Database to restore. This can be referenced from the `sakuracloud_database_backups` data source",
+						},
+					},
+				},
+			},
+			"maintenance_window": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The window that allows updates requiring a restart of the Database, such as changing `replica_password`, changing parameters listed in `restart_required_parameters`, restoring with `restore_from`, or replacing the Database by changing arguments such as `plan`",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_of_week": {
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.DaysOfTheWeekStrings, false)),
+							},
+							Set:      schema.HashString,
+							Optional: true,
+							Description: desc.Sprintf(
+								"A list of weekdays that the window starts. The values in the list must be in [%s]. If omitted, the window starts every day",
+								types.DaysOfTheWeekStrings,
+							),
+						},
+						"start_time": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateBackupTime(),
+							Description:      "The time that the window starts. This must be formatted with `HH:mm`",
+						},
+						"duration_hours": {
+							Type:             schema.TypeInt,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 24)),
+							Description:      desc.Sprintf("The length of the window in hours. %s", desc.Range(1, 24)),
+						},
+						"time_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Asia/Tokyo",
+							ValidateDiagFunc: validateWithCustomFunc(func(v string) error {
+								_, err := time.LoadLocation(v)
+								return err
+							}),
+							Description: "The IANA time zone name used to interpret `days_of_week` and `start_time`",
+						},
+						"force": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "The flag to allow updates requiring a restart even outside the window",
+						},
+					},
+				},
+			},
 			"monitoring_suite": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -259,7 +328,13 @@ func resourceSakuraCloudDatabase() *schema.Resource {
 					Type: schema.TypeString,
 				},
 				Optional:    true,
-				Description: "The map for setting RDBMS-specific parameters. Valid keys can be found with the `usacloud database list-parameters` command",
+				Description: "The map for setting RDBMS-specific parameters. Valid keys can be found with the `sakuracloud_database_parameters` data source or the `usacloud database list-parameters` command",
+			},
+			"restart_required_parameters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of parameters changed by the latest update that take effect after the Database is restarted",
 			},
 			"icon_id":     schemaResourceIconID(resourceName),
 			"description": schemaResourceDescription(resourceName),
@@ -269,6 +344,59 @@ func resourceSakuraCloudDatabase() *schema.Resource {
 	}
 }
 
+// resourceSakuraCloudDatabaseCustomizeDiff restore_from、再作成、parametersの検証を行う
+func resourceSakuraCloudDatabaseCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// 復元はアプライアンス自身のバックアップからのみ行えるため、作成(再作成を含む)時には指定できない
+	if d.Id() == "" && expandDatabaseRestoreBackupID(d) != "" {
+		return fmt.Errorf("restore_from: the data can only be restored from a backup of an existing Database")
+	}
+	// 再作成ではDeleteとCreateのどちらからも再作成であることが判別できないため、計画時にメンテナンスウィンドウを検証する
+	if d.Id() != "" {
+		window, err := expandDatabaseMaintenanceWindow(d)
+		if err != nil {
+			return err
+		}
+		if err := checkDatabaseReplacementWindow(window, time.Now(), databaseReplacementChanges(d)); err != nil {
+			return err
+		}
+	}
+	return customizeDatabaseParametersDiff(ctx, d, meta)
+}
+
+// customizeDatabaseParametersDiff parametersをパラメータ定義を元に検証し、反映に再起動が必要なパラメータを算出する
+//
+// restart_required_parametersには前回の更新の値が残らないよう、parametersの変更のたびに値を設定する。
+// 作成時や値が未確定の場合、パラメータ定義が取得できない場合は空とする。
+func customizeDatabaseParametersDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("parameters") {
+		return nil
+	}
+	restartRequired := []string{}
+	if !d.NewValueKnown("parameters") {
+		return d.SetNew("restart_required_parameters", restartRequired)
+	}
+
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return err
+	}
+	catalog, err := readDatabaseParameterCatalog(ctx, client, zone, sakuraCloudID(d.Id()), d.Get("database_type").(string), d.Get("database_version").(string))
+	if err != nil {
+		return fmt.Errorf("could not read parameters of SakuraCloud Database: %s", err)
+	}
+	// 同じ種別のアプライアンスが存在しない場合はパラメータ定義が取得できないため検証しない
+	if catalog != nil {
+		o, n := d.GetChange("parameters")
+		if err := catalog.validate(n.(map[string]interface{})); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			restartRequired = catalog.restartRequired(o.(map[string]interface{}), n.(map[string]interface{}))
+		}
+	}
+	return d.SetNew("restart_required_parameters", restartRequired)
+}
+
 func resourceSakuraCloudDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, zone, err := sakuraCloudClient(d, meta)
 	if err != nil {
@@ -329,6 +457,14 @@ func resourceSakuraCloudDatabaseUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("could not read SakuraCloud Database[%s]: %s", d.Id(), err)
 	}
 
+	window, err := expandDatabaseMaintenanceWindow(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkDatabaseMaintenanceWindow(window, time.Now(), databaseRestartRequiredChanges(d)); err != nil {
+		return diag.Errorf("updating SakuraCloud Database[%s] is failed: %s", d.Id(), err)
+	}
+
 	dbBuilder := expandDatabaseBuilder(d, client)
 	dbBuilder.Zone = zone
 	dbBuilder.ID = db.ID
@@ -337,6 +473,14 @@ func resourceSakuraCloudDatabaseUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("updating SakuraCloud Database[%s] is failed: %s", d.Id(), err)
 	}
 
+	if d.HasChange("restore_from") {
+		if backupID := expandDatabaseRestoreBackupID(d); backupID != "" {
+			if err := restoreDatabase(ctx, client, zone, db.ID, backupID); err != nil {
+				return diag.Errorf("restoring SakuraCloud Database[%s] is failed: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceSakuraCloudDatabaseRead(ctx, d, meta)
 }
 
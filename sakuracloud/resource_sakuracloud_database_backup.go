@@ -0,0 +1,227 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func resourceSakuraCloudDatabaseBackup() *schema.Resource {
+	resourceName := "Database Backup"
+
+	return &schema.Resource{
+		CreateContext: resourceSakuraCloudDatabaseBackupCreate,
+		ReadContext:   resourceSakuraCloudDatabaseBackupRead,
+		UpdateContext: resourceSakuraCloudDatabaseBackupUpdate,
+		DeleteContext: resourceSakuraCloudDatabaseBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSakuraCloudDatabaseBackupImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+				Description:      "The id of the database appliance to take the backup",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The flag to protect the backup from being deleted by the rotation of scheduled backups. The lock state is not returned by the API, so this is not refreshed from the actual backup",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the backup. This can be specified as `backup_id` of `restore_from` in `sakuracloud_database`",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date on which the backup was taken, in RFC3339 format",
+			},
+			"availability": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The availability of the backup",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the backup in bytes",
+			},
+			"zone": schemaResourceZone(resourceName),
+		},
+	}
+}
+
+func resourceSakuraCloudDatabaseBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	databaseID := d.Get("database_id").(string)
+	sakuraMutexKV.Lock(databaseID)
+	defer sakuraMutexKV.Unlock(databaseID)
+
+	dbOp := iaas.NewDatabaseOp(client)
+	backupOp := newDatabaseBackupOp(client)
+
+	status, err := dbOp.Status(ctx, zone, sakuraCloudID(databaseID))
+	if err != nil {
+		return diag.Errorf("could not read status of SakuraCloud Database[%s]: %s", databaseID, err)
+	}
+	if err := backupOp.Create(ctx, zone, sakuraCloudID(databaseID)); err != nil {
+		return diag.Errorf("creating SakuraCloud Database Backup is failed: Database[%s]: %s", databaseID, err)
+	}
+	backup, err := waitForDatabaseBackupCreated(ctx, dbOp, zone, sakuraCloudID(databaseID), status.Backups)
+	if err != nil {
+		return diag.Errorf("creating SakuraCloud Database Backup is failed: Database[%s]: %s", databaseID, err)
+	}
+
+	backupID := databaseBackupID(backup)
+	d.SetId(fmt.Sprintf("%s/%s", databaseID, backupID))
+	d.Set("backup_id", backupID) //nolint
+
+	if d.Get("locked").(bool) {
+		if err := backupOp.Lock(ctx, zone, sakuraCloudID(databaseID), backupID); err != nil {
+			return diag.Errorf("locking SakuraCloud Database Backup[%s] is failed: %s", d.Id(), err)
+		}
+	}
+	return resourceSakuraCloudDatabaseBackupRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudDatabaseBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	databaseID := d.Get("database_id").(string)
+	status, err := iaas.NewDatabaseOp(client).Status(ctx, zone, sakuraCloudID(databaseID))
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read status of SakuraCloud Database[%s]: %s", databaseID, err)
+	}
+
+	backup := findDatabaseBackupHistory(status.Backups, d.Get("backup_id").(string))
+	if backup == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("database_id", databaseID)                           //nolint
+	d.Set("backup_id", databaseBackupID(backup))               //nolint
+	d.Set("created_at", backup.CreatedAt.Format(time.RFC3339)) //nolint
+	d.Set("availability", backup.Availability)                 //nolint
+	d.Set("size", int(backup.Size))                            //nolint
+	d.Set("zone", getZone(d, client))                          //nolint
+	return nil
+}
+
+func resourceSakuraCloudDatabaseBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("locked") {
+		backupOp := newDatabaseBackupOp(client)
+		databaseID := sakuraCloudID(d.Get("database_id").(string))
+		backupID := d.Get("backup_id").(string)
+
+		if d.Get("locked").(bool) {
+			err = backupOp.Lock(ctx, zone, databaseID, backupID)
+		} else {
+			err = backupOp.Unlock(ctx, zone, databaseID, backupID)
+		}
+		if err != nil {
+			return diag.Errorf("updating SakuraCloud Database Backup[%s] is failed: %s", d.Id(), err)
+		}
+	}
+	return resourceSakuraCloudDatabaseBackupRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudDatabaseBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	databaseID := d.Get("database_id").(string)
+	sakuraMutexKV.Lock(databaseID)
+	defer sakuraMutexKV.Unlock(databaseID)
+
+	backupOp := newDatabaseBackupOp(client)
+	backupID := d.Get("backup_id").(string)
+
+	if d.Get("locked").(bool) {
+		if err := backupOp.Unlock(ctx, zone, sakuraCloudID(databaseID), backupID); err != nil && !iaas.IsNotFoundError(err) {
+			return diag.Errorf("unlocking SakuraCloud Database Backup[%s] is failed: %s", d.Id(), err)
+		}
+	}
+	if err := backupOp.Delete(ctx, zone, sakuraCloudID(databaseID), backupID); err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("deleting SakuraCloud Database Backup[%s] is failed: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// resourceSakuraCloudDatabaseBackupImport `<database_id>/<backup_id>`形式のIDでインポートする
+func resourceSakuraCloudDatabaseBackupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	databaseID, backupID, ok := strings.Cut(d.Id(), "/")
+	if !ok || databaseID == "" || backupID == "" {
+		return nil, fmt.Errorf("invalid import id %q: expected format is <database_id>/<backup_id>", d.Id())
+	}
+	if _, err := time.Parse(time.RFC3339, backupID); err != nil {
+		return nil, fmt.Errorf("invalid import id %q: backup_id must be in RFC3339 format", d.Id())
+	}
+
+	d.Set("database_id", databaseID) //nolint:errcheck,gosec
+	d.Set("backup_id", backupID)     //nolint:errcheck,gosec
+	// ロック状態はAPIから取得できないため、インポート時はロックされていないものとして扱う
+	d.Set("locked", false) //nolint:errcheck,gosec
+
+	if diags := resourceSakuraCloudDatabaseBackupRead(ctx, d, meta); diags.HasError() {
+		return nil, fmt.Errorf("could not read SakuraCloud Database Backup[%s]: %v", d.Id(), diags)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("backup %s is not found in SakuraCloud Database[%s]", backupID, databaseID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSakuraCloudDatabaseBackup_basic(t *testing.T) {
+	skipIfFakeModeEnabled(t)
+
+	resourceName := "sakuracloud_database_backup.foobar"
+	dataSourceName := "data.sakuracloud_database_backups.foobar"
+	rand := randomName()
+	password := randomPassword()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraCloudDatabaseDestroy,
+			testCheckSakuraCloudSwitchDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDatabaseBackup_basic, rand, password, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "database_id", "sakuracloud_database.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "locked", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "backup_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "created_at", resourceName, "backup_id"),
+					resource.TestCheckResourceAttr(resourceName, "availability", "available"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDatabaseBackup_basic, rand, password, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "locked", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "backups.0.id", resourceName, "backup_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"locked"},
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudDatabaseBackup_basic = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_database" "foobar" {
+  database_type = "mariadb"
+  plan          = "10g"
+
+  username = "defuser"
+  password = "{{ .arg1 }}"
+
+  network_interface {
+    switch_id  = sakuracloud_switch.foobar.id
+    ip_address = "192.168.110.101"
+    netmask    = 24
+    gateway    = "192.168.110.1"
+    port       = 3306
+  }
+
+  backup {
+    time     = "00:00"
+    weekdays = ["mon"]
+  }
+
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_database_backup" "foobar" {
+  database_id = sakuracloud_database.foobar.id
+  locked      = {{ .arg2 }}
+}
+
+data "sakuracloud_database_backups" "foobar" {
+  database_id = sakuracloud_database.foobar.id
+
+  depends_on = [sakuracloud_database_backup.foobar]
+}
+`
@@ -0,0 +1,217 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakuracloud/internal/desc"
+)
+
+func resourceSakuraCloudDatabaseGrant() *schema.Resource {
+	resourceName := "database grant"
+
+	return &schema.Resource{
+		CreateContext: resourceSakuraCloudDatabaseGrantCreate,
+		ReadContext:   resourceSakuraCloudDatabaseGrantRead,
+		UpdateContext: resourceSakuraCloudDatabaseGrantUpdate,
+		DeleteContext: resourceSakuraCloudDatabaseGrantDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: schemaResourceDatabaseSQLConnection(resourceName, map[string]*schema.Schema{
+			"user": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.NoZeroValues),
+				Description:      "The name of the database user to grant privileges to",
+			},
+			"allowed_host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The host part of the database user. This is only supported on MariaDB. Default: `%`",
+			},
+			"schema": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.NoZeroValues),
+				Description:      "The name of the schema to grant privileges on",
+			},
+			"privileges": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(databaseAllPrivileges(), false)),
+				},
+				Set: schema.HashString,
+				Description: desc.Sprintf(
+					"A set of privileges to grant. MariaDB accepts [%s]. PostgreSQL accepts [%s] on the schema and [%s] on all tables in the schema",
+					databaseMariaDBPrivileges,
+					databasePostgresSchemaPrivileges,
+					databasePostgresTablePrivileges,
+				),
+			},
+		}),
+	}
+}
+
+func resourceSakuraCloudDatabaseGrantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	user := d.Get("user").(string)
+	schemaName := d.Get("schema").(string)
+	privileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+	var host string
+
+	err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, info *databaseSQLConnInfo) error {
+		var err error
+		host, err = expandDatabaseUserAllowedHost(d, info.databaseType)
+		if err != nil {
+			return err
+		}
+		if err := validateDatabasePrivileges(info.databaseType, privileges); err != nil {
+			return err
+		}
+		return client.Grant(ctx, user, host, schemaName, privileges)
+	})
+	if err != nil {
+		return diag.Errorf("creating SakuraCloud Database Grant is failed: Database[%s]: %s", d.Get("database_id"), err)
+	}
+
+	d.SetId(databaseUserID(d.Get("database_id").(string), user, host) + "/" + schemaName)
+	d.Set("allowed_host", host) //nolint
+	return resourceSakuraCloudDatabaseGrantRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudDatabaseGrantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	user := d.Get("user").(string)
+	schemaName := d.Get("schema").(string)
+	var host string
+
+	var privileges []string
+	err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, info *databaseSQLConnInfo) error {
+		var err error
+		host, err = expandDatabaseUserAllowedHost(d, info.databaseType)
+		if err != nil {
+			return err
+		}
+		privileges, err = client.ReadPrivileges(ctx, user, host, schemaName)
+		return err
+	})
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read SakuraCloud Database Grant[%s]: %s", d.Id(), err)
+	}
+	if len(privileges) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("allowed_host", host) //nolint
+	if err := d.Set("privileges", privileges); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceSakuraCloudDatabaseGrantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("privileges") {
+		o, n := d.GetChange("privileges")
+		revoked := expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		granted := expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		user := d.Get("user").(string)
+		host := d.Get("allowed_host").(string)
+		schemaName := d.Get("schema").(string)
+
+		err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, info *databaseSQLConnInfo) error {
+			if err := validateDatabasePrivileges(info.databaseType, granted); err != nil {
+				return err
+			}
+			if err := client.Revoke(ctx, user, host, schemaName, revoked); err != nil {
+				return err
+			}
+			return client.Grant(ctx, user, host, schemaName, granted)
+		})
+		if err != nil {
+			return diag.Errorf("updating SakuraCloud Database Grant[%s] is failed: %s", d.Id(), err)
+		}
+	}
+	return resourceSakuraCloudDatabaseGrantRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudDatabaseGrantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, _ *databaseSQLConnInfo) error {
+		return client.Revoke(ctx,
+			d.Get("user").(string),
+			d.Get("allowed_host").(string),
+			d.Get("schema").(string),
+			expandStringList(d.Get("privileges").(*schema.Set).List()),
+		)
+	})
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("deleting SakuraCloud Database Grant[%s] is failed: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// databaseAllPrivileges MariaDB/PostgreSQLのいずれかで指定可能な権限の一覧を返す
+func databaseAllPrivileges() []string {
+	var privileges []string
+	for _, p := range append(databasePrivileges("mariadb"), databasePrivileges("postgres")...) {
+		if !slices.Contains(privileges, p) {
+			privileges = append(privileges, p)
+		}
+	}
+	slices.Sort(privileges)
+	return privileges
+}
+
+func validateDatabasePrivileges(databaseType string, privileges []string) error {
+	available := databasePrivileges(databaseType)
+	var invalid []string
+	for _, p := range privileges {
+		if !slices.Contains(available, p) {
+			invalid = append(invalid, p)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("privileges [%s] are not supported on %s", strings.Join(invalid, ", "), databaseType)
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ package sakuracloud
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,6 +25,7 @@ import (
 	"github.com/sacloud/iaas-api-go"
 	"github.com/sacloud/iaas-api-go/helper/power"
 	"github.com/sacloud/iaas-api-go/types"
+	databaseBuilder "github.com/sacloud/iaas-service-go/database/builder"
 	"github.com/sacloud/terraform-provider-sakuracloud/internal/desc"
 )
 
@@ -34,8 +36,9 @@ func resourceSakuraCloudDatabaseReadReplica() *schema.Resource {
 		ReadContext:   resourceSakuraCloudDatabaseReadReplicaRead,
 		UpdateContext: resourceSakuraCloudDatabaseReadReplicaUpdate,
 		DeleteContext: resourceSakuraCloudDatabaseReadReplicaDelete,
+		CustomizeDiff: resourceSakuraCloudDatabaseReadReplicaCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -48,9 +51,14 @@ func resourceSakuraCloudDatabaseReadReplica() *schema.Resource {
 			"master_id": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ForceNew:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
-				Description:      "The id of the replication master database",
+				Description:      "The id of the replication master database. Changing this repoints the replication to the new master database without re-creating the read-replica. This can not be changed when `promote` is `true`",
+			},
+			"promote": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "The flag to promote the read-replica to a standalone master database. This can only be set to `true` on an existing read-replica. Changing this back to `false` forces a new resource to be created",
 			},
 			"replica_password": {
 				Type:        schema.TypeString,
@@ -207,9 +215,20 @@ func resourceSakuraCloudDatabaseReadReplicaUpdate(ctx context.Context, d *schema
 		return diag.Errorf("could not read SakuraCloud Database[%s]: %s", d.Id(), err)
 	}
 
-	builder, err := expandDatabaseReadReplicaBuilder(ctx, d, client, zone)
-	if err != nil {
-		return nil
+	sakuraMutexKV.Lock(d.Id())
+	defer sakuraMutexKV.Unlock(d.Id())
+
+	var builder *databaseBuilder.Builder
+	if d.Get("promote").(bool) {
+		builder = expandPromotedDatabaseReadReplicaBuilder(d, client, zone, db)
+	} else {
+		builder, err = expandDatabaseReadReplicaBuilder(ctx, d, client, zone)
+		if err != nil {
+			return diag.Errorf("updating SakuraCloud Database ReadReplica[%s] is failed: %s", d.Id(), err)
+		}
+		if builder.Conf.DatabaseName != db.Conf.DatabaseName || builder.Conf.DatabaseVersion != db.Conf.DatabaseVersion {
+			return diag.Errorf("updating SakuraCloud Database ReadReplica[%s] is failed: master database instance[%s] has different database type or version", d.Id(), d.Get("master_id"))
+		}
 	}
 	builder.ID = db.ID
 
@@ -264,8 +283,13 @@ func setDatabaseReadReplicaResourceData(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	d.Set("master_id", data.ReplicationSetting.ApplianceID.String()) //nolint
-	d.Set("name", data.Name)                                         //nolint
+	// 昇格済みの場合は元のマスターの情報がAPIから取得できないため、stateの値を維持する
+	promoted := isPromotedDatabaseReadReplica(data)
+	if !promoted {
+		d.Set("master_id", data.ReplicationSetting.ApplianceID.String()) //nolint
+	}
+	d.Set("promote", promoted) //nolint
+	d.Set("name", data.Name)   //nolint
 	if err := d.Set("network_interface", flattenDatabaseReadReplicaNetworkInterface(data)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -277,3 +301,22 @@ func setDatabaseReadReplicaResourceData(ctx context.Context, d *schema.ResourceD
 	d.Set("zone", getZone(d, client))      //nolint
 	return nil
 }
+
+func resourceSakuraCloudDatabaseReadReplicaCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	o, n := d.GetChange("promote")
+	switch {
+	case d.Id() == "" && n.(bool):
+		return fmt.Errorf("promote: can only be set to true on an existing read-replica")
+	case o.(bool) && !n.(bool):
+		return d.ForceNew("promote")
+	// 昇格済みのデータベースは元のマスターとの関係を持たないため、master_idを変更しても反映先がない
+	case d.Id() != "" && n.(bool) && d.HasChange("master_id"):
+		return fmt.Errorf("master_id: can not be changed on a promoted read-replica")
+	}
+	return nil
+}
+
+// isPromotedDatabaseReadReplica レプリケーションのスレーブとして動作していない(昇格済み)かを返す
+func isPromotedDatabaseReadReplica(db *iaas.Database) bool {
+	return db.ReplicationSetting == nil || db.ReplicationSetting.Model != types.DatabaseReplicationModels.AsyncReplica
+}
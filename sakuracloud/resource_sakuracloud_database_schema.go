@@ -0,0 +1,132 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func resourceSakuraCloudDatabaseSchema() *schema.Resource {
+	resourceName := "database schema"
+
+	return &schema.Resource{
+		CreateContext: resourceSakuraCloudDatabaseSchemaCreate,
+		ReadContext:   resourceSakuraCloudDatabaseSchemaRead,
+		UpdateContext: resourceSakuraCloudDatabaseSchemaUpdate,
+		DeleteContext: resourceSakuraCloudDatabaseSchemaDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: schemaResourceDatabaseSQLConnection(resourceName, map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: isValidLengthBetween(1, 63),
+				Description:      "The name of the schema",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the user that owns the schema. This is only supported on PostgreSQL",
+			},
+		}),
+	}
+}
+
+func resourceSakuraCloudDatabaseSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, _ *databaseSQLConnInfo) error {
+		_, exists, err := client.ReadSchema(ctx, name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("schema %q already exists", name)
+		}
+		return client.CreateSchema(ctx, name, d.Get("owner").(string))
+	})
+	if err != nil {
+		return diag.Errorf("creating SakuraCloud Database Schema is failed: Database[%s]: %s", d.Get("database_id"), err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("database_id"), name))
+	return resourceSakuraCloudDatabaseSchemaRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudDatabaseSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
+	var owner string
+	var exists bool
+	err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, _ *databaseSQLConnInfo) error {
+		var err error
+		owner, exists, err = client.ReadSchema(ctx, name)
+		return err
+	})
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read SakuraCloud Database Schema[%s]: %s", d.Id(), err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)   //nolint
+	d.Set("owner", owner) //nolint
+	return nil
+}
+
+func resourceSakuraCloudDatabaseSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if owner := d.Get("owner").(string); d.HasChange("owner") && owner != "" {
+		err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, _ *databaseSQLConnInfo) error {
+			return client.UpdateSchemaOwner(ctx, d.Get("name").(string), owner)
+		})
+		if err != nil {
+			return diag.Errorf("updating SakuraCloud Database Schema[%s] is failed: %s", d.Id(), err)
+		}
+	}
+	return resourceSakuraCloudDatabaseSchemaRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudDatabaseSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, _ *databaseSQLConnInfo) error {
+		return client.DropSchema(ctx, d.Get("name").(string))
+	})
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("deleting SakuraCloud Database Schema[%s] is failed: %s", d.Id(), err)
+	}
+	return nil
+}
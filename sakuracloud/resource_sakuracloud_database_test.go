@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -146,6 +148,49 @@ func TestAccSakuraCloudDatabase_withContinuousBackup(t *testing.T) {
 	})
 }
 
+func TestAccSakuraCloudDatabase_maintenanceWindow(t *testing.T) {
+	skipIfFakeModeEnabled(t)
+
+	resourceName := "sakuracloud_database.foobar"
+	rand := randomName()
+	password := randomPassword()
+	replicaPassword := randomPassword()
+	replicaPasswordUpd := randomPassword()
+
+	var database iaas.Database
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraCloudDatabaseDestroy,
+			testCheckSakuraCloudSwitchDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDatabase_maintenanceWindow, rand, password, replicaPassword, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudDatabaseExists(resourceName, &database),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.start_time", "02:00"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.time_zone", "Asia/Tokyo"),
+				),
+			},
+			{
+				// メンテナンスウィンドウ外(日曜 02:00-03:00 以外)での再起動を伴う更新は拒否される
+				Config:      buildConfigWithArgs(testAccSakuraCloudDatabase_maintenanceWindow, rand, password, replicaPasswordUpd, "false"),
+				ExpectError: regexp.MustCompile("not allowed outside the maintenance window"),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDatabase_maintenanceWindow, rand, password, replicaPasswordUpd, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudDatabaseExists(resourceName, &database),
+					resource.TestCheckResourceAttr(resourceName, "replica_password", replicaPasswordUpd),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_window.0.force", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSakuraCloudDatabase_withDiskEncryption(t *testing.T) {
 	if isFakeModeEnabled() {
 		t.Skip()
@@ -181,6 +226,57 @@ func TestAccSakuraCloudDatabase_withDiskEncryption(t *testing.T) {
 	})
 }
 
+func TestAccSakuraCloudDatabase_restoreFrom(t *testing.T) {
+	skipIfZoneIsDummy(t)
+
+	resourceName := "sakuracloud_database.foobar"
+	rand := randomName()
+	password := randomPassword()
+
+	var backupID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraCloudDatabaseDestroy,
+			testCheckSakuraCloudInternetDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				// バックアップ取得後に作成したスキーマが復元によって消えることを確認する
+				Config: buildConfigWithArgs(testAccSakuraCloudDatabase_restoreFrom, rand, password, ""),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources["sakuracloud_database_backup.foobar"]
+						if !ok {
+							return errors.New("not found: sakuracloud_database_backup.foobar")
+						}
+						backupID = rs.Primary.Attributes["backup_id"]
+						return nil
+					},
+					testCheckSakuraCloudDatabaseCreateSchema(resourceName, password, "after_backup"),
+				),
+			},
+			{
+				PreConfig: func() {
+					t.Setenv("TF_VAR_backup_id", backupID)
+				},
+				Config: buildConfigWithArgs(testAccSakuraCloudDatabase_restoreFrom, rand, password, `
+  restore_from {
+    backup_id = var.backup_id
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "restore_from.0.backup_id", &backupID),
+					testCheckSakuraCloudDatabaseSchemaExists(resourceName, password, "before_backup", true),
+					testCheckSakuraCloudDatabaseSchemaExists(resourceName, password, "after_backup", false),
+				),
+				// 復元によりsakuracloud_database_schemaで作成したスキーマも消えるため、再作成の差分が残る
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testCheckSakuraCloudDatabaseExists(n string, database *iaas.Database) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -250,6 +346,54 @@ func testCheckSakuraCloudDatabaseDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testCheckSakuraCloudDatabaseSQLClient(s *terraform.State, n, password string) (databaseSQLClient, error) {
+	rs, ok := s.RootModule().Resources[n]
+	if !ok {
+		return nil, fmt.Errorf("not found: %s", n)
+	}
+	port, err := strconv.Atoi(rs.Primary.Attributes["network_interface.0.port"])
+	if err != nil {
+		return nil, err
+	}
+	return openDatabaseSQLClient(context.Background(), &databaseSQLConnInfo{
+		databaseType: rs.Primary.Attributes["database_type"],
+		host:         rs.Primary.Attributes["network_interface.0.ip_address"],
+		port:         port,
+		user:         rs.Primary.Attributes["username"],
+		password:     password,
+	})
+}
+
+func testCheckSakuraCloudDatabaseCreateSchema(n, password, schema string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := testCheckSakuraCloudDatabaseSQLClient(s, n, password)
+		if err != nil {
+			return err
+		}
+		defer client.Close() //nolint:errcheck
+		return client.CreateSchema(context.Background(), schema, "")
+	}
+}
+
+func testCheckSakuraCloudDatabaseSchemaExists(n, password, schema string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := testCheckSakuraCloudDatabaseSQLClient(s, n, password)
+		if err != nil {
+			return err
+		}
+		defer client.Close() //nolint:errcheck
+
+		_, found, err := client.ReadSchema(context.Background(), schema)
+		if err != nil {
+			return err
+		}
+		if found != exists {
+			return fmt.Errorf("schema %q: expected exists=%t, got %t", schema, exists, found)
+		}
+		return nil
+	}
+}
+
 func TestAccImportSakuraCloudDatabase_basic(t *testing.T) {
 	name := randomName()
 	password := randomPassword()
@@ -396,6 +540,35 @@ resource "sakuracloud_database" "foobar" {
   tags        = ["tag1-upd", "tag2-upd"]
 }`
 
+const testAccSakuraCloudDatabase_maintenanceWindow = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+resource "sakuracloud_database" "foobar" {
+  database_type = "mariadb"
+
+  username         = "defuser"
+  password         = "{{ .arg1 }}"
+  replica_password = "{{ .arg2 }}"
+
+  network_interface {
+    switch_id  = sakuracloud_switch.foobar.id
+    ip_address = "192.168.11.101"
+    netmask    = 24
+    gateway    = "192.168.11.1"
+    port       = 33061
+  }
+
+  maintenance_window {
+    days_of_week   = ["sun"]
+    start_time     = "02:00"
+    duration_hours = 1
+    force          = {{ .arg3 }}
+  }
+
+  name = "{{ .arg0 }}"
+}`
+
 const testAccSakuraCloudDatabase_import = `
 resource "sakuracloud_switch" "foobar" {
   name = "{{ .arg0 }}"
@@ -511,3 +684,46 @@ resource "sakuracloud_database" "foobar" {
   }
 }
 `
+
+var testAccSakuraCloudDatabase_restoreFrom = `
+variable "backup_id" {
+  type    = string
+  default = ""
+}
+
+resource "sakuracloud_internet" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_database" "foobar" {
+  database_type = "postgres"
+  plan          = "10g"
+
+  username = "defuser"
+  password = "{{ .arg1 }}"
+
+  network_interface {
+    switch_id     = sakuracloud_internet.foobar.switch_id
+    ip_address    = sakuracloud_internet.foobar.ip_addresses[0]
+    netmask       = sakuracloud_internet.foobar.netmask
+    gateway       = sakuracloud_internet.foobar.gateway
+    port          = 5432
+    source_ranges = ["0.0.0.0/0"]
+  }
+{{ .arg2 }}
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_database_schema" "foobar" {
+  database_id    = sakuracloud_database.foobar.id
+  admin_password = "{{ .arg1 }}"
+
+  name = "before_backup"
+}
+
+resource "sakuracloud_database_backup" "foobar" {
+  database_id = sakuracloud_database.foobar.id
+
+  depends_on = [sakuracloud_database_schema.foobar]
+}
+`
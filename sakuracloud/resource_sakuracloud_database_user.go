@@ -0,0 +1,173 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func resourceSakuraCloudDatabaseUser() *schema.Resource {
+	resourceName := "database user"
+
+	return &schema.Resource{
+		CreateContext: resourceSakuraCloudDatabaseUserCreate,
+		ReadContext:   resourceSakuraCloudDatabaseUserRead,
+		UpdateContext: resourceSakuraCloudDatabaseUserUpdate,
+		DeleteContext: resourceSakuraCloudDatabaseUserDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: schemaResourceDatabaseSQLConnection(resourceName, map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: isValidLengthBetween(1, 63),
+				Description:      "The name of the database user",
+			},
+			"password": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.NoZeroValues),
+				Description:      "The password of the database user",
+			},
+			"allowed_host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The host that the user is allowed to connect from (e.g. `%`, `192.168.0.%`). This is only supported on MariaDB. Default: `%`",
+			},
+		}),
+	}
+}
+
+func resourceSakuraCloudDatabaseUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	password := d.Get("password").(string)
+	var host string
+
+	err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, info *databaseSQLConnInfo) error {
+		var err error
+		host, err = expandDatabaseUserAllowedHost(d, info.databaseType)
+		if err != nil {
+			return err
+		}
+		exists, err := client.ReadUser(ctx, name, host)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("user %q already exists", name)
+		}
+		return client.CreateUser(ctx, name, host, password)
+	})
+	if err != nil {
+		return diag.Errorf("creating SakuraCloud Database User is failed: Database[%s]: %s", d.Get("database_id"), err)
+	}
+
+	d.SetId(databaseUserID(d.Get("database_id").(string), name, host))
+	d.Set("allowed_host", host) //nolint
+	return resourceSakuraCloudDatabaseUserRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudDatabaseUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	var host string
+
+	var exists bool
+	err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, info *databaseSQLConnInfo) error {
+		var err error
+		host, err = expandDatabaseUserAllowedHost(d, info.databaseType)
+		if err != nil {
+			return err
+		}
+		exists, err = client.ReadUser(ctx, name, host)
+		return err
+	})
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read SakuraCloud Database User[%s]: %s", d.Id(), err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)         //nolint
+	d.Set("allowed_host", host) //nolint
+	return nil
+}
+
+func resourceSakuraCloudDatabaseUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("password") {
+		err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, _ *databaseSQLConnInfo) error {
+			return client.UpdateUserPassword(ctx, d.Get("name").(string), d.Get("allowed_host").(string), d.Get("password").(string))
+		})
+		if err != nil {
+			return diag.Errorf("updating SakuraCloud Database User[%s] is failed: %s", d.Id(), err)
+		}
+	}
+	return resourceSakuraCloudDatabaseUserRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudDatabaseUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := withDatabaseSQLClient(ctx, d, meta, func(client databaseSQLClient, _ *databaseSQLConnInfo) error {
+		return client.DropUser(ctx, d.Get("name").(string), d.Get("allowed_host").(string))
+	})
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("deleting SakuraCloud Database User[%s] is failed: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// expandDatabaseUserAllowedHost allowed_hostの値を返す。MariaDBで未指定の場合は全てのホストを許可する
+func expandDatabaseUserAllowedHost(d resourceValueGettable, databaseType string) (string, error) {
+	host := d.Get("allowed_host").(string)
+	switch {
+	case databaseType == "mariadb" && host == "":
+		return "%", nil
+	case databaseType != "mariadb" && host != "":
+		return "", fmt.Errorf("allowed_host is only supported on MariaDB")
+	}
+	return host, nil
+}
+
+func databaseUserID(databaseID, name, host string) string {
+	id := fmt.Sprintf("%s/%s", databaseID, name)
+	if host != "" {
+		id += "@" + host
+	}
+	return id
+}
@@ -0,0 +1,188 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSakuraCloudDatabaseUser_withSchemaAndGrant(t *testing.T) {
+	skipIfZoneIsDummy(t)
+
+	rand := randomName()
+	password := randomPassword()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraCloudDatabaseDestroy,
+			testCheckSakuraCloudInternetDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDatabaseUser_withSchemaAndGrant, rand, password, `["SELECT", "USAGE"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sakuracloud_database_user.foobar", "name", "app_user"),
+					resource.TestCheckResourceAttr("sakuracloud_database_user.foobar", "allowed_host", ""),
+					resource.TestCheckResourceAttr("sakuracloud_database_schema.foobar", "name", "app"),
+					resource.TestCheckResourceAttr("sakuracloud_database_schema.foobar", "owner", "defuser"),
+					resource.TestCheckResourceAttr("sakuracloud_database_grant.foobar", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sakuracloud_database_grant.foobar", "privileges.*", "SELECT"),
+					resource.TestCheckTypeSetElemAttr("sakuracloud_database_grant.foobar", "privileges.*", "USAGE"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDatabaseUser_withSchemaAndGrant, rand, password, `["INSERT", "SELECT", "UPDATE", "USAGE"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sakuracloud_database_grant.foobar", "privileges.#", "4"),
+					resource.TestCheckTypeSetElemAttr("sakuracloud_database_grant.foobar", "privileges.*", "INSERT"),
+					resource.TestCheckTypeSetElemAttr("sakuracloud_database_grant.foobar", "privileges.*", "UPDATE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSakuraCloudDatabaseUser_mariaDB(t *testing.T) {
+	skipIfZoneIsDummy(t)
+
+	rand := randomName()
+	password := randomPassword()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraCloudDatabaseDestroy,
+			testCheckSakuraCloudInternetDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				// allowed_hostを省略した場合は全てのホスト(%)が許可される
+				Config: buildConfigWithArgs(testAccSakuraCloudDatabaseUser_mariaDB, rand, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sakuracloud_database_user.foobar", "name", "app_user"),
+					resource.TestCheckResourceAttr("sakuracloud_database_user.foobar", "allowed_host", "%"),
+					resource.TestCheckResourceAttr("sakuracloud_database_schema.foobar", "name", "app"),
+					resource.TestCheckResourceAttr("sakuracloud_database_grant.foobar", "allowed_host", "%"),
+					resource.TestCheckResourceAttr("sakuracloud_database_grant.foobar", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sakuracloud_database_grant.foobar", "privileges.*", "SELECT"),
+					resource.TestCheckTypeSetElemAttr("sakuracloud_database_grant.foobar", "privileges.*", "INSERT"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudDatabaseUser_withSchemaAndGrant = `
+resource "sakuracloud_internet" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_database" "foobar" {
+  database_type = "postgres"
+  plan          = "10g"
+
+  username = "defuser"
+  password = "{{ .arg1 }}"
+
+  network_interface {
+    switch_id     = sakuracloud_internet.foobar.switch_id
+    ip_address    = sakuracloud_internet.foobar.ip_addresses[0]
+    netmask       = sakuracloud_internet.foobar.netmask
+    gateway       = sakuracloud_internet.foobar.gateway
+    port          = 5432
+    source_ranges = ["0.0.0.0/0"]
+  }
+
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_database_user" "foobar" {
+  database_id    = sakuracloud_database.foobar.id
+  admin_password = "{{ .arg1 }}"
+
+  name     = "app_user"
+  password = "{{ .arg1 }}"
+}
+
+resource "sakuracloud_database_schema" "foobar" {
+  database_id    = sakuracloud_database.foobar.id
+  admin_password = "{{ .arg1 }}"
+
+  name = "app"
+}
+
+resource "sakuracloud_database_grant" "foobar" {
+  database_id    = sakuracloud_database.foobar.id
+  admin_password = "{{ .arg1 }}"
+
+  user       = sakuracloud_database_user.foobar.name
+  schema     = sakuracloud_database_schema.foobar.name
+  privileges = {{ .arg2 }}
+}
+`
+
+var testAccSakuraCloudDatabaseUser_mariaDB = `
+resource "sakuracloud_internet" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_database" "foobar" {
+  database_type = "mariadb"
+  plan          = "10g"
+
+  username = "defuser"
+  password = "{{ .arg1 }}"
+
+  network_interface {
+    switch_id     = sakuracloud_internet.foobar.switch_id
+    ip_address    = sakuracloud_internet.foobar.ip_addresses[0]
+    netmask       = sakuracloud_internet.foobar.netmask
+    gateway       = sakuracloud_internet.foobar.gateway
+    port          = 3306
+    source_ranges = ["0.0.0.0/0"]
+  }
+
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_database_user" "foobar" {
+  database_id    = sakuracloud_database.foobar.id
+  admin_password = "{{ .arg1 }}"
+
+  name     = "app_user"
+  password = "{{ .arg1 }}"
+}
+
+resource "sakuracloud_database_schema" "foobar" {
+  database_id    = sakuracloud_database.foobar.id
+  admin_password = "{{ .arg1 }}"
+
+  name = "app"
+}
+
+resource "sakuracloud_database_grant" "foobar" {
+  database_id    = sakuracloud_database.foobar.id
+  admin_password = "{{ .arg1 }}"
+
+  user       = sakuracloud_database_user.foobar.name
+  schema     = sakuracloud_database_schema.foobar.name
+  privileges = ["INSERT", "SELECT"]
+}
+`
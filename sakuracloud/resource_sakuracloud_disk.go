@@ -38,7 +38,7 @@ func resourceSakuraCloudDisk() *schema.Resource {
 		UpdateContext: resourceSakuraCloudDiskUpdate,
 		DeleteContext: resourceSakuraCloudDiskDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
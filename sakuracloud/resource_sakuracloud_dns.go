@@ -33,6 +33,7 @@ func resourceSakuraCloudDNS() *schema.Resource {
 		ReadContext:   resourceSakuraCloudDNSRead,
 		UpdateContext: resourceSakuraCloudDNSUpdate,
 		DeleteContext: resourceSakuraCloudDNSDelete,
+		CustomizeDiff: resourceSakuraCloudDNSCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -74,9 +75,10 @@ func resourceSakuraCloudDNS() *schema.Resource {
 							),
 						},
 						"value": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The value of the DNS Record",
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressDNSRecordValueDiff,
+							Description:      "The value of the DNS Record",
 						},
 						"ttl": {
 							Type:        schema.TypeInt,
@@ -36,6 +36,10 @@ func resourceSakuraCloudDNSRecord() *schema.Resource {
 		CreateContext: resourceSakuraCloudDNSRecordCreate,
 		ReadContext:   resourceSakuraCloudDNSRecordRead,
 		DeleteContext: resourceSakuraCloudDNSRecordDelete,
+		CustomizeDiff: resourceSakuraCloudDNSRecordCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSakuraCloudDNSRecordImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -67,10 +71,11 @@ func resourceSakuraCloudDNSRecord() *schema.Resource {
 				),
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The value of the DNS Record",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDNSRecordValueDiff,
+				Description:      "The value of the DNS Record",
 			},
 			"ttl": {
 				Type:        schema.TypeInt,
@@ -196,6 +201,47 @@ func resourceSakuraCloudDNSRecordDelete(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
+// resourceSakuraCloudDNSRecordImport `<dns_id>/<name>/<type>/<value>`形式のIDでインポートする
+//
+// MX/SRVレコードのvalueにはpriorityなどを含まないホスト名部分を指定する。
+func resourceSakuraCloudDNSRecordImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*APIClient)
+
+	parts := strings.SplitN(d.Id(), "/", 4)
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("invalid import id %q: expected format is <dns_id>/<name>/<type>/<value>", d.Id())
+	}
+	dnsID, name, recordType, value := parts[0], parts[1], strings.ToUpper(parts[2]), parts[3]
+
+	dns, err := iaas.NewDNSOp(client).Read(ctx, sakuraCloudID(dnsID))
+	if err != nil {
+		return nil, fmt.Errorf("could not read SakuraCloud DNS[%s]: %s", dnsID, err)
+	}
+
+	for _, record := range dns.Records {
+		if record.Name != name || record.Type.String() != recordType {
+			continue
+		}
+		r := flattenDNSRecord(record)
+		if normalizeDNSRecordValue(recordType, r["value"].(string)) != normalizeDNSRecordValue(recordType, value) {
+			continue
+		}
+
+		d.Set("dns_id", dnsID)    //nolint:errcheck,gosec
+		d.Set("type", recordType) //nolint:errcheck,gosec
+		for _, key := range []string{"name", "value", "ttl", "priority", "weight", "port"} {
+			if v, ok := r[key]; ok {
+				if err := d.Set(key, v); err != nil {
+					return nil, err
+				}
+			}
+		}
+		d.SetId(dnsRecordIDHash(dnsID, record))
+		return []*schema.ResourceData{d}, nil
+	}
+	return nil, fmt.Errorf("DNS record %q is not found in SakuraCloud DNS[%s]", strings.Join(parts[1:], "/"), dnsID)
+}
+
 func findRecordMatch(records []*iaas.DNSRecord, record *iaas.DNSRecord) *iaas.DNSRecord {
 	for _, r := range records {
 		if isSameDNSRecord(r, record) {
@@ -205,7 +251,8 @@ func findRecordMatch(records []*iaas.DNSRecord, record *iaas.DNSRecord) *iaas.DN
 	return nil
 }
 func isSameDNSRecord(r1, r2 *iaas.DNSRecord) bool {
-	return r1.Name == r2.Name && r1.RData == r2.RData && r1.TTL == r2.TTL && r1.Type == r2.Type
+	return r1.Name == r2.Name && r1.TTL == r2.TTL && r1.Type == r2.Type &&
+		normalizeDNSRecordValue(r1.Type.String(), r1.RData) == normalizeDNSRecordValue(r2.Type.String(), r2.RData)
 }
 
 func dnsRecordIDHash(dns_id string, r *iaas.DNSRecord) string {
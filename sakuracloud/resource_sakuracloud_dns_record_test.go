@@ -92,6 +92,47 @@ func TestAccSakuraCloudDNSRecord_withCount(t *testing.T) {
 	})
 }
 
+func TestAccImportSakuraCloudDNSRecord_basic(t *testing.T) {
+	zone := fmt.Sprintf("%s.com", randomName())
+
+	importStateIDFunc := func(name string) resource.ImportStateIdFunc {
+		return func(s *terraform.State) (string, error) {
+			rs, ok := s.RootModule().Resources[name]
+			if !ok {
+				return "", fmt.Errorf("not found: %s", name)
+			}
+			attrs := rs.Primary.Attributes
+			return fmt.Sprintf("%s/%s/%s/%s", attrs["dns_id"], attrs["name"], attrs["type"], attrs["value"]), nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraCloudDNSDestroy,
+			testCheckSakuraCloudDNSRecordDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudDNSRecord_basic, zone),
+			},
+			{
+				ResourceName:      "sakuracloud_dns_record.foobar1",
+				ImportState:       true,
+				ImportStateIdFunc: importStateIDFunc("sakuracloud_dns_record.foobar1"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sakuracloud_dns_record.foobar2",
+				ImportState:       true,
+				ImportStateIdFunc: importStateIDFunc("sakuracloud_dns_record.foobar2"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckSakuraCloudDNSRecordDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*APIClient)
 	dnsOp := iaas.NewDNSOp(client)
@@ -31,6 +31,9 @@ func resourceSakuraCloudIcon() *schema.Resource {
 		ReadContext:   resourceSakuraCloudIconRead,
 		UpdateContext: resourceSakuraCloudIconUpdate,
 		DeleteContext: resourceSakuraCloudIconDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -41,20 +44,22 @@ func resourceSakuraCloudIcon() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"name": schemaResourceName(resourceName),
 			"source": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ConflictsWith: []string{"base64content"},
-				ForceNew:      true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"base64content"},
+				ForceNew:         true,
+				DiffSuppressFunc: suppressIconContentDiffAfterImport,
 				Description: desc.Sprintf(
 					"The file path to upload to as the Icon. %s",
 					desc.Conflicts("base64content"),
 				),
 			},
 			"base64content": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ConflictsWith: []string{"source"},
-				ForceNew:      true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"source"},
+				ForceNew:         true,
+				DiffSuppressFunc: suppressIconContentDiffAfterImport,
 				Description: desc.Sprintf(
 					"The base64 encoded content to upload to as the Icon. %s",
 					desc.Conflicts("source"),
@@ -156,3 +161,10 @@ func setIconResourceData(ctx context.Context, d *schema.ResourceData, client *AP
 	d.Set("url", data.URL)   //nolint
 	return diag.FromErr(d.Set("tags", flattenTags(data.Tags)))
 }
+
+// suppressIconContentDiffAfterImport アイコンの内容はAPIから取得できないため、インポート直後(stateが空)の差分を無視する
+//
+// source/base64contentを切り替えた場合は変更前の値が空でない側で差分が検出されるため再作成される。
+func suppressIconContentDiffAfterImport(_, old, _ string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
@@ -59,6 +59,27 @@ func TestAccSakuraCloudIcon_basic(t *testing.T) {
 	})
 }
 
+func TestAccImportSakuraCloudIcon_basic(t *testing.T) {
+	name := randomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudIconDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudIcon_basic, name),
+			},
+			{
+				ResourceName:            "sakuracloud_icon.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source", "base64content"},
+			},
+		},
+	})
+}
+
 func TestAccSakuraCloudIcon_withSwitch(t *testing.T) {
 	resourceName := "sakuracloud_icon.foobar"
 	name := randomName()
@@ -37,7 +37,7 @@ func resourceSakuraCloudInternet() *schema.Resource {
 		UpdateContext: resourceSakuraCloudInternetUpdate,
 		DeleteContext: resourceSakuraCloudInternetDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -31,7 +31,7 @@ func resourceSakuraCloudIPv4Ptr() *schema.Resource {
 		UpdateContext: resourceSakuraCloudIPv4PtrUpdate,
 		DeleteContext: resourceSakuraCloudIPv4PtrDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
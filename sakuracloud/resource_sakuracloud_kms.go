@@ -20,6 +20,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	api "github.com/sacloud/api-client-go"
 	"github.com/sacloud/kms-api-go"
 	v1 "github.com/sacloud/kms-api-go/apis/v1"
@@ -37,6 +38,7 @@ func resourceSakuraCloudKMS() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceSakuraCloudKMSCustomizeDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -48,7 +50,6 @@ func resourceSakuraCloudKMS() *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: desc.Sprintf("The name of the %s.", resourceName),
 			},
 			"key_origin": {
@@ -66,6 +67,50 @@ func resourceSakuraCloudKMS() *schema.Resource {
 				Description: "Plain key for imported KMS key. Required when `key_origin` is 'imported'.",
 				Sensitive:   true,
 			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(kmsKeyStatuses(), false)),
+				Description: desc.Sprintf(
+					"The status of the %s. This must be one of [%s]. While the key is `pending_destruction`, this is read-only and changes to it are ignored",
+					resourceName, kmsKeyStatuses(),
+				),
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: desc.Sprintf("The latest version of the %s", resourceName),
+			},
+			"rotation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval_days": {
+							Type:             schema.TypeInt,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+							Description:      "The number of days between key rotations. The key is rotated on the first apply after this period has elapsed since `rotated_at`",
+						},
+					},
+				},
+			},
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: desc.Sprintf("The time when the %s was last rotated by Terraform. This is the creation time until the first rotation", resourceName),
+			},
+			"deletion_pending_days": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(7, 90)),
+				Description: desc.Sprintf(
+					"The number of days to wait before the %s is destroyed. When specified, destroying the resource schedules the deletion instead of deleting the key immediately. %s",
+					resourceName, desc.Range(7, 90),
+				),
+			},
 			"description": schemaResourceDescription(resourceName),
 			"tags":        schemaResourceTags(resourceName),
 		},
@@ -89,14 +134,16 @@ func resourceSakuraCloudKMSCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("create KMS queue failed: %s", err)
 	}
 
-	key := v1.Key{
-		ID:          createdKey.ID,
-		Name:        createdKey.Name,
-		Description: createdKey.Description.Value,
-		KeyOrigin:   createdKey.KeyOrigin,
-		Tags:        createdKey.Tags,
+	d.SetId(createdKey.ID)
+	d.Set("rotated_at", string(createdKey.CreatedAt)) //nolint:errcheck,gosec
+
+	if status := expandKMSKeyStatus(d); status != "" && status != v1.ChangeKeyStatusStatusActive {
+		if err := keyOp.ChangeStatus(ctx, createdKey.ID, status); err != nil {
+			return diag.Errorf("could not change KMS[%s] key status: %s", createdKey.ID, err)
+		}
 	}
-	return setKMSResourceData(d, &key)
+
+	return resourceSakuraCloudKMSRead(ctx, d, meta)
 }
 
 func resourceSakuraCloudKMSRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -115,6 +162,10 @@ func resourceSakuraCloudKMSRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("could not read KMS[%s] key: %s", d.Id(), err)
 	}
 
+	// インポート時などrotated_atが未設定の場合は作成日時を起点とする
+	if d.Get("rotated_at").(string) == "" {
+		d.Set("rotated_at", string(key.CreatedAt)) //nolint:errcheck,gosec
+	}
 	return setKMSResourceData(d, key)
 }
 
@@ -130,8 +181,29 @@ func resourceSakuraCloudKMSUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("could not read KMS[%s] key: %s", d.Id(), err)
 	}
 
-	if _, err = keyOp.Update(ctx, key.ID, expandKMSUpdateKey(d, key)); err != nil {
-		return diag.Errorf("could not update KMS[%s] key: %s", d.Id(), err)
+	if d.HasChanges("name", "description", "tags") {
+		if _, err = keyOp.Update(ctx, key.ID, expandKMSUpdateKey(d, key)); err != nil {
+			return diag.Errorf("could not update KMS[%s] key: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("status") {
+		if status := expandKMSKeyStatus(d); status != "" {
+			if err := keyOp.ChangeStatus(ctx, key.ID, status); err != nil {
+				return diag.Errorf("could not change KMS[%s] key status: %s", d.Id(), err)
+			}
+		}
+	}
+
+	// CustomizeDiffでrotated_atを未確定にしているため、判定には変更前の値を用いる
+	rotatedAt, _ := d.GetChange("rotated_at")
+	if isKMSRotationDue(d, rotatedAt.(string), time.Now()) {
+		if _, err := keyOp.Rotate(ctx, key.ID); err != nil {
+			return diag.Errorf("could not rotate KMS[%s] key: %s", d.Id(), err)
+		}
+		d.Set("rotated_at", time.Now().UTC().Format(time.RFC3339)) //nolint:errcheck,gosec
+	} else {
+		d.Set("rotated_at", rotatedAt) //nolint:errcheck,gosec
 	}
 
 	return resourceSakuraCloudKMSRead(ctx, d, meta)
@@ -153,16 +225,44 @@ func resourceSakuraCloudKMSDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("could not read KMS[%s] key: %s", d.Id(), err)
 	}
 
+	if days, ok := d.GetOk("deletion_pending_days"); ok {
+		if err := keyOp.ScheduleDestruction(ctx, key.ID, days.(int)); err != nil {
+			return diag.Errorf("could not schedule destruction of KMS[%s] key: %s", d.Id(), err)
+		}
+		return nil
+	}
+
 	if err := keyOp.Delete(ctx, key.ID); err != nil {
 		return diag.Errorf("could not delete KMS[%s] key: %s", d.Id(), err)
 	}
 	return nil
 }
 
+func resourceSakuraCloudKMSCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	// pending_destructionなどstatusで指定できない状態のキーはstatusを変更できないため、APIの値を維持する
+	if o, _ := d.GetChange("status"); d.HasChange("status") && !isKMSKeyStatusChangeable(o.(string)) {
+		if err := d.Clear("status"); err != nil {
+			return err
+		}
+	}
+	if isKMSRotationDue(d, d.Get("rotated_at").(string), time.Now()) {
+		if err := d.SetNewComputed("latest_version"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("rotated_at")
+	}
+	return nil
+}
+
 func setKMSResourceData(d *schema.ResourceData, data *v1.Key) diag.Diagnostics {
 	d.SetId(data.ID)
-	d.Set("name", data.Name)                    //nolint:errcheck,gosec
-	d.Set("key_origin", string(data.KeyOrigin)) //nolint:errcheck,gosec
-	d.Set("description", data.Description)      //nolint:errcheck,gosec
+	d.Set("name", data.Name)                          //nolint:errcheck,gosec
+	d.Set("key_origin", string(data.KeyOrigin))       //nolint:errcheck,gosec
+	d.Set("description", data.Description)            //nolint:errcheck,gosec
+	d.Set("status", string(data.Status))              //nolint:errcheck,gosec
+	d.Set("latest_version", data.LatestVersion.Value) //nolint:errcheck,gosec
 	return diag.FromErr(d.Set("tags", flattenTags(data.Tags)))
 }
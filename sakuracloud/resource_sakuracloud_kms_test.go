@@ -104,6 +104,42 @@ func TestAccSakuraCloudKMS_imported(t *testing.T) {
 	})
 }
 
+func TestAccSakuraCloudKMS_lifecycle(t *testing.T) {
+	skipIfFakeModeEnabled(t)
+
+	resourceName := "sakuracloud_kms.foobar"
+	rand := randomName()
+
+	var key v1.Key
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudKMSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudKMS_lifecycle, rand, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudKMSExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "name", rand),
+					resource.TestCheckResourceAttr(resourceName, "status", "active"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.interval_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_pending_days", "7"),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "rotated_at"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudKMS_lifecycle, rand+"-upd", "suspended"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudKMSExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "name", rand+"-upd"),
+					resource.TestCheckResourceAttr(resourceName, "status", "suspended"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckSakuraCloudKMSDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*APIClient)
 	keyOp := kms.NewKeyOp(client.kmsClient)
@@ -116,8 +152,8 @@ func testCheckSakuraCloudKMSDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := keyOp.Read(context.Background(), rs.Primary.ID)
-		if err == nil {
+		key, err := keyOp.Read(context.Background(), rs.Primary.ID)
+		if err == nil && key.Status != v1.KeyStatusEnumPendingDestruction {
 			return fmt.Errorf("still exists KMS: %s", rs.Primary.ID)
 		}
 	}
@@ -183,3 +219,14 @@ resource "sakuracloud_kms" "foobar2" {
   tags        = ["tag1"]
   key_origin  = "imported"
 }`
+
+var testAccSakuraCloudKMS_lifecycle = `
+resource "sakuracloud_kms" "foobar" {
+  name   = "{{ .arg0 }}"
+  status = "{{ .arg1 }}"
+
+  rotation {
+    interval_days = 30
+  }
+  deletion_pending_days = 7
+}`
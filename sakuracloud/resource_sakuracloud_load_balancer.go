@@ -38,7 +38,7 @@ func resourceSakuraCloudLoadBalancer() *schema.Resource {
 		UpdateContext: resourceSakuraCloudLoadBalancerUpdate,
 		DeleteContext: resourceSakuraCloudLoadBalancerDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -174,6 +174,7 @@ func resourceSakuraCloudLoadBalancer() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_healthy": schemaResourceWaitForHealthy(resourceName),
 		},
 	}
 }
@@ -215,6 +216,10 @@ func resourceSakuraCloudLoadBalancerCreate(ctx context.Context, d *schema.Resour
 		return diag.Errorf("creating SakuraCloud LoadBalancer is failed: created resource is not *iaas.LoadBalancer")
 	}
 	d.SetId(lb.ID.String())
+
+	if err := waitForBackendsHealthy(ctx, expandWaitForHealthy(d), loadBalancerBackendHealthReader(lbOp, zone, lb.ID)); err != nil {
+		return diag.Errorf("waiting for servers of SakuraCloud LoadBalancer[%s] to become healthy is failed: %s", lb.ID, err)
+	}
 	return resourceSakuraCloudLoadBalancerRead(ctx, d, meta)
 }
 
@@ -257,6 +262,9 @@ func resourceSakuraCloudLoadBalancerUpdate(ctx context.Context, d *schema.Resour
 		return diag.Errorf("updating SakuraCloud LoadBalancer[%s] is failed: %s", d.Id(), err)
 	}
 
+	if err := waitForBackendsHealthy(ctx, expandWaitForHealthy(d), loadBalancerBackendHealthReader(lbOp, zone, lb.ID)); err != nil {
+		return diag.Errorf("waiting for servers of SakuraCloud LoadBalancer[%s] to become healthy is failed: %s", d.Id(), err)
+	}
 	return resourceSakuraCloudLoadBalancerRead(ctx, d, meta)
 }
 
@@ -37,7 +37,7 @@ func resourceSakuraCloudMobileGateway() *schema.Resource {
 		UpdateContext: resourceSakuraCloudMobileGatewayUpdate,
 		DeleteContext: resourceSakuraCloudMobileGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -38,7 +38,7 @@ func resourceSakuraCloudNFS() *schema.Resource {
 		UpdateContext: resourceSakuraCloudNFSUpdate,
 		DeleteContext: resourceSakuraCloudNFSDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
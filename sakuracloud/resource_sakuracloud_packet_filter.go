@@ -36,7 +36,7 @@ func resourceSakuraCloudPacketFilter() *schema.Resource {
 		UpdateContext: resourceSakuraCloudPacketFilterUpdate,
 		DeleteContext: resourceSakuraCloudPacketFilterDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
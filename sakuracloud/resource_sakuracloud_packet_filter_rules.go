@@ -33,7 +33,11 @@ func resourceSakuraCloudPacketFilterRules() *schema.Resource {
 		ReadContext:   resourceSakuraCloudPacketFilterRulesRead,
 		DeleteContext: resourceSakuraCloudPacketFilterRulesDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
+		},
+		CustomizeDiff: resourceSakuraCloudPacketFilterRulesCustomizeDiff,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			resourceSakuraCloudPacketFilterRulesValidateRawConfig,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -15,6 +15,7 @@
 package sakuracloud
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -82,6 +83,22 @@ func TestAccSakuraCloudPacketFilterRules_basic(t *testing.T) {
 	})
 }
 
+func TestAccSakuraCloudPacketFilterRules_validateExpression(t *testing.T) {
+	rand := randomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudPacketFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      buildConfigWithArgs(testAccSakuraCloudPacketFilterRules_invalidExpression, rand),
+				ExpectError: regexp.MustCompile("source_port and destination_port can only be specified when protocol is tcp or udp"),
+			},
+		},
+	})
+}
+
 var testAccSakuraCloudPacketFilterRules_basic = `
 resource "sakuracloud_packet_filter" "foobar" {
   name        = "{{ .arg0 }}"
@@ -138,3 +155,17 @@ resource sakuracloud_packet_filter_rules "rules" {
   }
 }
 `
+
+var testAccSakuraCloudPacketFilterRules_invalidExpression = `
+resource "sakuracloud_packet_filter" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource sakuracloud_packet_filter_rules "rules" {
+  packet_filter_id = sakuracloud_packet_filter.foobar.id
+  expression {
+    protocol         = "icmp"
+    destination_port = "22"
+  }
+}
+`
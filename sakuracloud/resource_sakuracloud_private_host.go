@@ -37,7 +37,7 @@ func resourceSakuraCloudPrivateHost() *schema.Resource {
 		UpdateContext: resourceSakuraCloudPrivateHostUpdate,
 		DeleteContext: resourceSakuraCloudPrivateHostDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -283,6 +283,7 @@ func resourceSakuraCloudProxyLB() *schema.Resource {
 						"additional_certificate": {
 							Type:     schema.TypeList,
 							Optional: true,
+							Computed: true,
 							MaxItems: 19,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -311,136 +312,15 @@ func resourceSakuraCloudProxyLB() *schema.Resource {
 			"server": {
 				Type:     schema.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 40,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"ip_address": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The IP address of the destination server",
-						},
-						"port": {
-							Type:             schema.TypeInt,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
-							Description:      desc.Sprintf("The port number of the destination server. %s", desc.Range(1, 65535)),
-						},
-						"group": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: isValidLengthBetween(1, 10),
-							Description: desc.Sprintf(
-								"The name of load balancing group. This is used when using rule-based load balancing. %s",
-								desc.Length(1, 10),
-							),
-						},
-						"enabled": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     true,
-							Description: "The flag to enable as destination of load balancing",
-						},
-					},
-				},
+				Elem:     schemaResourceProxyLBServer(),
 			},
 			"rule": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"host": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The value of HTTP host header that is used as condition of rule-based balancing",
-						},
-						"path": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The request path that is used as condition of rule-based balancing",
-						},
-						"source_ips": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "IP address or CIDR block to which the rule will be applied. Multiple values can be specified by separating them with a space or comma",
-						},
-						"request_header_name": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The header name that the client will send when making a request",
-						},
-						"request_header_value": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The condition for the value of the request header specified by the request header name",
-						},
-						"request_header_value_ignore_case": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Boolean value representing whether the request header value ignores case",
-						},
-						"request_header_value_not_match": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Boolean value representing whether to apply the rules when the request header value conditions are met or when the conditions do not match",
-						},
-						"group": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: isValidLengthBetween(1, 10),
-							Description: desc.Sprintf(
-								"The name of load balancing group. When proxyLB received request which matched to `host` and `path`, proxyLB forwards the request to servers that having same group name. %s",
-								desc.Length(1, 10),
-							),
-						},
-						"action": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							Default:          types.ProxyLBRuleActions.Forward,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.ProxyLBRuleActionStrings(), false)),
-							Description: desc.Sprintf(
-								"The type of action to be performed when requests matches the rule. This must be one of [%s]",
-								types.ProxyLBRuleActionStrings(),
-							),
-						},
-						"redirect_location": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The URL to redirect to when the request matches the rule. see https://manual.sakura.ad.jp/cloud/appliance/enhanced-lb/#enhanced-lb-rule for details",
-						},
-						"redirect_status_code": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.ProxyLBRedirectStatusCodeStrings(), false)),
-							Description: desc.Sprintf(
-								"HTTP status code for redirects sent when requests matches the rule. This must be one of [%s]",
-								types.ProxyLBRedirectStatusCodeStrings(),
-							),
-						},
-						"fixed_status_code": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.ProxyLBFixedStatusCodeStrings(), false)),
-							Description: desc.Sprintf(
-								"HTTP status code for fixed response sent when requests matches the rule. This must be one of [%s]",
-								types.ProxyLBFixedStatusCodeStrings(),
-							),
-						},
-						"fixed_content_type": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.ProxyLBFixedContentTypeStrings(), false)),
-							Description: desc.Sprintf(
-								"Content-Type header value for fixed response sent when requests matches the rule. This must be one of [%s]",
-								types.ProxyLBFixedContentTypeStrings(),
-							),
-						},
-						"fixed_message_body": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Content body for fixed response sent when requests matches the rule",
-						},
-					},
-				},
+				Computed: true,
+				Elem:     schemaResourceProxyLBRule(),
 			},
 			"letsencrypt": {
 				Type:     schema.TypeList,
@@ -483,9 +363,10 @@ func resourceSakuraCloudProxyLB() *schema.Resource {
 					},
 				},
 			},
-			"icon_id":     schemaResourceIconID(resourceName),
-			"description": schemaResourceDescription(resourceName),
-			"tags":        schemaResourceTags(resourceName),
+			"wait_for_healthy": schemaResourceWaitForHealthy(resourceName),
+			"icon_id":          schemaResourceIconID(resourceName),
+			"description":      schemaResourceDescription(resourceName),
+			"tags":             schemaResourceTags(resourceName),
 			"fqdn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -506,6 +387,137 @@ func resourceSakuraCloudProxyLB() *schema.Resource {
 	}
 }
 
+func schemaResourceProxyLBServer() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ip_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The IP address of the destination server",
+			},
+			"port": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
+				Description:      desc.Sprintf("The port number of the destination server. %s", desc.Range(1, 65535)),
+			},
+			"group": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isValidLengthBetween(1, 10),
+				Description: desc.Sprintf(
+					"The name of load balancing group. This is used when using rule-based load balancing. %s",
+					desc.Length(1, 10),
+				),
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The flag to enable as destination of load balancing",
+			},
+		},
+	}
+}
+
+func schemaResourceProxyLBRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The value of HTTP host header that is used as condition of rule-based balancing",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The request path that is used as condition of rule-based balancing",
+			},
+			"source_ips": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IP address or CIDR block to which the rule will be applied. Multiple values can be specified by separating them with a space or comma",
+			},
+			"request_header_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The header name that the client will send when making a request",
+			},
+			"request_header_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The condition for the value of the request header specified by the request header name",
+			},
+			"request_header_value_ignore_case": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Boolean value representing whether the request header value ignores case",
+			},
+			"request_header_value_not_match": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Boolean value representing whether to apply the rules when the request header value conditions are met or when the conditions do not match",
+			},
+			"group": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isValidLengthBetween(1, 10),
+				Description: desc.Sprintf(
+					"The name of load balancing group. When proxyLB received request which matched to `host` and `path`, proxyLB forwards the request to servers that having same group name. %s",
+					desc.Length(1, 10),
+				),
+			},
+			"action": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.ProxyLBRuleActions.Forward,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.ProxyLBRuleActionStrings(), false)),
+				Description: desc.Sprintf(
+					"The type of action to be performed when requests matches the rule. This must be one of [%s]",
+					types.ProxyLBRuleActionStrings(),
+				),
+			},
+			"redirect_location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL to redirect to when the request matches the rule. see https://manual.sakura.ad.jp/cloud/appliance/enhanced-lb/#enhanced-lb-rule for details",
+			},
+			"redirect_status_code": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.ProxyLBRedirectStatusCodeStrings(), false)),
+				Description: desc.Sprintf(
+					"HTTP status code for redirects sent when requests matches the rule. This must be one of [%s]",
+					types.ProxyLBRedirectStatusCodeStrings(),
+				),
+			},
+			"fixed_status_code": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.ProxyLBFixedStatusCodeStrings(), false)),
+				Description: desc.Sprintf(
+					"HTTP status code for fixed response sent when requests matches the rule. This must be one of [%s]",
+					types.ProxyLBFixedStatusCodeStrings(),
+				),
+			},
+			"fixed_content_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.ProxyLBFixedContentTypeStrings(), false)),
+				Description: desc.Sprintf(
+					"Content-Type header value for fixed response sent when requests matches the rule. This must be one of [%s]",
+					types.ProxyLBFixedContentTypeStrings(),
+				),
+			},
+			"fixed_message_body": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Content body for fixed response sent when requests matches the rule",
+			},
+		},
+	}
+}
+
 func resourceSakuraCloudProxyLBCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, err := sakuraCloudClient(d, meta)
 	if err != nil {
@@ -530,6 +542,10 @@ func resourceSakuraCloudProxyLBCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.SetId(proxyLB.ID.String())
+
+	if err := waitForBackendsHealthy(ctx, expandWaitForHealthy(d), proxyLBBackendHealthReader(proxyLBOp, proxyLB.ID)); err != nil {
+		return diag.Errorf("waiting for servers of SakuraCloud ProxyLB[%s] to become healthy is failed: %s", proxyLB.ID, err)
+	}
 	return resourceSakuraCloudProxyLBRead(ctx, d, meta)
 }
 
@@ -568,7 +584,15 @@ func resourceSakuraCloudProxyLBUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("could not read SakuraCloud ProxyLB[%s]: %s", d.Id(), err)
 	}
 
-	proxyLB, err = proxyLBOp.Update(ctx, proxyLB.ID, expandProxyLBUpdateRequest(d))
+	req := expandProxyLBUpdateRequest(d)
+	// 設定に記載されていないserver/ruleはサブリソースで管理されている場合があるため、stateではなく現在の値を引き継ぐ
+	if ctyIsEmpty(d.GetRawConfig(), "server") {
+		req.Servers = proxyLB.Servers
+	}
+	if ctyIsEmpty(d.GetRawConfig(), "rule") {
+		req.Rules = proxyLB.Rules
+	}
+	proxyLB, err = proxyLBOp.Update(ctx, proxyLB.ID, req)
 	if err != nil {
 		return diag.Errorf("updating SakuraCloud ProxyLB[%s] is failed: %s", d.Id(), err)
 	}
@@ -588,7 +612,25 @@ func resourceSakuraCloudProxyLBUpdate(ctx context.Context, d *schema.ResourceDat
 
 	if proxyLB.LetsEncrypt == nil && d.HasChange("certificate") {
 		certs := expandProxyLBCerts(d)
-		if certs == nil {
+		// 設定に記載されていない追加証明書はサブリソースで管理されている場合があるため、stateではなく現在の値を引き継ぐ
+		if !isProxyLBAdditionalCertsInConfig(d.GetRawConfig()) {
+			current, err := proxyLBOp.GetCertificates(ctx, proxyLB.ID)
+			if err != nil {
+				return diag.Errorf("reading Certificates of ProxyLB[%s] is failed: %s", d.Id(), err)
+			}
+			if certs == nil {
+				certs = &iaas.ProxyLBCertificates{}
+			}
+			certs.AdditionalCerts = nil
+			for _, cert := range current.AdditionalCerts {
+				certs.AdditionalCerts = append(certs.AdditionalCerts, &iaas.ProxyLBAdditionalCert{
+					ServerCertificate:       cert.ServerCertificate,
+					IntermediateCertificate: cert.IntermediateCertificate,
+					PrivateKey:              cert.PrivateKey,
+				})
+			}
+		}
+		if certs == nil || (certs.PrimaryCert == nil && len(certs.AdditionalCerts) == 0) {
 			if err := proxyLBOp.DeleteCertificates(ctx, proxyLB.ID); err != nil {
 				return diag.Errorf("deleting Certificates of ProxyLB[%s] is failed: %s", d.Id(), err)
 			}
@@ -601,6 +643,10 @@ func resourceSakuraCloudProxyLBUpdate(ctx context.Context, d *schema.ResourceDat
 			}
 		}
 	}
+
+	if err := waitForBackendsHealthy(ctx, expandWaitForHealthy(d), proxyLBBackendHealthReader(proxyLBOp, proxyLB.ID)); err != nil {
+		return diag.Errorf("waiting for servers of SakuraCloud ProxyLB[%s] to become healthy is failed: %s", d.Id(), err)
+	}
 	return resourceSakuraCloudProxyLBRead(ctx, d, meta)
 }
 
@@ -630,6 +676,50 @@ func resourceSakuraCloudProxyLBDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// updateProxyLBSettings ProxyLBの現在の設定に対しupdaterで変更を加えて反映する
+//
+// sakuracloud_proxylbや他のサブリソースと同じProxyLBのIDでロックを取得する
+func updateProxyLBSettings(ctx context.Context, d *schema.ResourceData, meta interface{}, updater func(proxyLB *iaas.ProxyLB) error) error {
+	client, _, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	proxyLBOp := iaas.NewProxyLBOp(client)
+	proxyLBID := d.Get("proxylb_id").(string)
+
+	sakuraMutexKV.Lock(proxyLBID)
+	defer sakuraMutexKV.Unlock(proxyLBID)
+
+	proxyLB, err := proxyLBOp.Read(ctx, sakuraCloudID(proxyLBID))
+	if err != nil {
+		return err
+	}
+	if err := updater(proxyLB); err != nil {
+		return err
+	}
+
+	_, err = proxyLBOp.UpdateSettings(ctx, proxyLB.ID, &iaas.ProxyLBUpdateSettingsRequest{
+		HealthCheck:          proxyLB.HealthCheck,
+		SorryServer:          proxyLB.SorryServer,
+		BindPorts:            proxyLB.BindPorts,
+		Servers:              proxyLB.Servers,
+		Rules:                proxyLB.Rules,
+		LetsEncrypt:          proxyLB.LetsEncrypt,
+		StickySession:        proxyLB.StickySession,
+		Timeout:              proxyLB.Timeout,
+		Gzip:                 proxyLB.Gzip,
+		BackendHttpKeepAlive: proxyLB.BackendHttpKeepAlive,
+		MonitoringSuiteLog:   proxyLB.MonitoringSuiteLog,
+		ProxyProtocol:        proxyLB.ProxyProtocol,
+		Syslog:               proxyLB.Syslog,
+		OriginGuard:          proxyLB.OriginGuard,
+		StrictRule:           proxyLB.StrictRule,
+		SettingsHash:         proxyLB.SettingsHash,
+	})
+	return err
+}
+
 func setProxyLBResourceData(ctx context.Context, d *schema.ResourceData, client *APIClient, data *iaas.ProxyLB) diag.Diagnostics {
 	// certificates
 	proxyLBOp := iaas.NewProxyLBOp(client)
@@ -0,0 +1,373 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func resourceSakuraCloudProxyLBCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSakuraCloudProxyLBCertificateCreate,
+		ReadContext:   resourceSakuraCloudProxyLBCertificateRead,
+		UpdateContext: resourceSakuraCloudProxyLBCertificateUpdate,
+		DeleteContext: resourceSakuraCloudProxyLBCertificateDelete,
+		CustomizeDiff: resourceSakuraCloudProxyLBCertificateCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSakuraCloudProxyLBCertificateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"proxylb_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+				Description:      "The id of the ProxyLB that set the certificate to",
+			},
+			"server_cert": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validateWithCustomFunc(func(v string) error {
+					_, err := parseProxyLBCertificate(v)
+					return err
+				}),
+				Description: "The certificate for a server",
+			},
+			"intermediate_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The intermediate certificate for a server",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The private key for a server",
+			},
+			"renew_before_days": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "The number of days before the expiry of the certificate to force replacement of the resource. Setting `0` disables replacement",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The common name of the certificate. This is used as the SNI name",
+			},
+			"subject_alt_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The subject alternative names of the certificate",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date on which the certificate validity period ends, in RFC3339 format",
+			},
+			"sha256_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the certificate, in hex format",
+			},
+		},
+	}
+}
+
+func resourceSakuraCloudProxyLBCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	proxyLBID := d.Get("proxylb_id").(string)
+	serverCert, err := parseProxyLBCertificate(d.Get("server_cert").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	commonName := serverCert.Subject.CommonName
+
+	err = updateProxyLBCertificates(ctx, d, meta, func(certs *iaas.ProxyLBCertificates) error {
+		if findProxyLBAdditionalCert(certs.AdditionalCerts, commonName) != nil {
+			return fmt.Errorf("certificate for %q already exists", commonName)
+		}
+		certs.AdditionalCerts = append(certs.AdditionalCerts, &iaas.ProxyLBAdditionalCert{
+			ServerCertificate:       d.Get("server_cert").(string),
+			IntermediateCertificate: d.Get("intermediate_cert").(string),
+			PrivateKey:              d.Get("private_key").(string),
+		})
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("creating SakuraCloud ProxyLB Certificate is failed: ProxyLB[%s]: %s", proxyLBID, err)
+	}
+
+	d.SetId(proxyLBCertificateID(proxyLBID, commonName))
+	return resourceSakuraCloudProxyLBCertificateRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudProxyLBCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	proxyLBID := d.Get("proxylb_id").(string)
+	certs, err := iaas.NewProxyLBOp(client).GetCertificates(ctx, sakuraCloudID(proxyLBID))
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read Certificates of SakuraCloud ProxyLB[%s]: %s", proxyLBID, err)
+	}
+
+	cert := findProxyLBAdditionalCert(certs.AdditionalCerts, proxyLBCertificateCommonName(d))
+	if cert == nil {
+		d.SetId("")
+		return nil
+	}
+	return setProxyLBCertificateResourceData(d, cert)
+}
+
+func resourceSakuraCloudProxyLBCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// renew_before_days以外の項目は全てForceNewのため、stateの更新のみ行う
+	return resourceSakuraCloudProxyLBCertificateRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudProxyLBCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	proxyLBID := d.Get("proxylb_id").(string)
+	commonName := proxyLBCertificateCommonName(d)
+
+	err := updateProxyLBCertificates(ctx, d, meta, func(certs *iaas.ProxyLBCertificates) error {
+		var additionalCerts []*iaas.ProxyLBAdditionalCert
+		for _, cert := range certs.AdditionalCerts {
+			if cert.CertificateCommonName == commonName {
+				continue
+			}
+			additionalCerts = append(additionalCerts, cert)
+		}
+		certs.AdditionalCerts = additionalCerts
+		return nil
+	})
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("deleting SakuraCloud ProxyLB Certificate is failed: ProxyLB[%s]: %s", proxyLBID, err)
+	}
+	return nil
+}
+
+func resourceSakuraCloudProxyLBCertificateCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if err := validateProxyLBCertificateKeyPair(d.GetRawConfig()); err != nil {
+		return err
+	}
+
+	// 証明書が変更される場合は置き換えが行われるため判定不要
+	renewBeforeDays := d.Get("renew_before_days").(int)
+	if d.Id() == "" || renewBeforeDays == 0 || d.HasChange("server_cert") {
+		return nil
+	}
+	cert, err := parseProxyLBCertificate(d.Get("server_cert").(string))
+	if err != nil {
+		return err
+	}
+	if !isProxyLBCertificateRenewalRequired(cert, renewBeforeDays, time.Now()) {
+		return nil
+	}
+	if err := d.SetNewComputed("not_after"); err != nil {
+		return err
+	}
+	return d.ForceNew("not_after")
+}
+
+// resourceSakuraCloudProxyLBCertificateImport `<proxylb_id>/<common_name>`形式のIDでインポートする
+func resourceSakuraCloudProxyLBCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*APIClient)
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import id %q: expected format is <proxylb_id>/<common_name>", d.Id())
+	}
+	proxyLBID, commonName := parts[0], parts[1]
+
+	certs, err := iaas.NewProxyLBOp(client).GetCertificates(ctx, sakuraCloudID(proxyLBID))
+	if err != nil {
+		return nil, fmt.Errorf("could not read Certificates of SakuraCloud ProxyLB[%s]: %s", proxyLBID, err)
+	}
+	cert := findProxyLBAdditionalCert(certs.AdditionalCerts, commonName)
+	if cert == nil {
+		return nil, fmt.Errorf("certificate for %q is not found in SakuraCloud ProxyLB[%s]", commonName, proxyLBID)
+	}
+
+	d.Set("proxylb_id", proxyLBID) //nolint:errcheck,gosec
+	if diags := setProxyLBCertificateResourceData(d, cert); diags.HasError() {
+		return nil, fmt.Errorf("could not set certificate for %q: %v", commonName, diags)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func setProxyLBCertificateResourceData(d *schema.ResourceData, cert *iaas.ProxyLBAdditionalCert) diag.Diagnostics {
+	actual, err := parseProxyLBCertificate(cert.ServerCertificate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// APIから返される証明書はstateの値と改行などが異なる可能性があるため、内容が変わった場合のみ反映する
+	current, err := parseProxyLBCertificate(d.Get("server_cert").(string))
+	if err != nil || proxyLBCertificateFingerprint(current) != proxyLBCertificateFingerprint(actual) {
+		d.Set("server_cert", cert.ServerCertificate)             //nolint
+		d.Set("intermediate_cert", cert.IntermediateCertificate) //nolint
+		d.Set("private_key", cert.PrivateKey)                    //nolint
+	}
+
+	d.Set("common_name", actual.Subject.CommonName)                    //nolint
+	d.Set("not_after", actual.NotAfter.Format(time.RFC3339))           //nolint
+	d.Set("sha256_fingerprint", proxyLBCertificateFingerprint(actual)) //nolint
+	if err := d.Set("subject_alt_names", actual.DNSNames); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(proxyLBCertificateID(d.Get("proxylb_id").(string), actual.Subject.CommonName))
+	return nil
+}
+
+// updateProxyLBCertificates ProxyLBの現在の証明書に対しupdaterで変更を加えて反映する
+//
+// sakuracloud_proxylbや他のサブリソースと同じProxyLBのIDでロックを取得する
+func updateProxyLBCertificates(ctx context.Context, d *schema.ResourceData, meta interface{}, updater func(certs *iaas.ProxyLBCertificates) error) error {
+	client, _, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	proxyLBOp := iaas.NewProxyLBOp(client)
+	proxyLBID := d.Get("proxylb_id").(string)
+
+	sakuraMutexKV.Lock(proxyLBID)
+	defer sakuraMutexKV.Unlock(proxyLBID)
+
+	certs, err := proxyLBOp.GetCertificates(ctx, sakuraCloudID(proxyLBID))
+	if err != nil {
+		return err
+	}
+	if err := updater(certs); err != nil {
+		return err
+	}
+
+	req := &iaas.ProxyLBSetCertificatesRequest{}
+	if certs.PrimaryCert != nil && certs.PrimaryCert.ServerCertificate != "" {
+		req.PrimaryCerts = &iaas.ProxyLBPrimaryCert{
+			ServerCertificate:       certs.PrimaryCert.ServerCertificate,
+			IntermediateCertificate: certs.PrimaryCert.IntermediateCertificate,
+			PrivateKey:              certs.PrimaryCert.PrivateKey,
+		}
+	}
+	for _, cert := range certs.AdditionalCerts {
+		req.AdditionalCerts = append(req.AdditionalCerts, &iaas.ProxyLBAdditionalCert{
+			ServerCertificate:       cert.ServerCertificate,
+			IntermediateCertificate: cert.IntermediateCertificate,
+			PrivateKey:              cert.PrivateKey,
+		})
+	}
+
+	if req.PrimaryCerts == nil && len(req.AdditionalCerts) == 0 {
+		return proxyLBOp.DeleteCertificates(ctx, sakuraCloudID(proxyLBID))
+	}
+	_, err = proxyLBOp.SetCertificates(ctx, sakuraCloudID(proxyLBID), req)
+	return err
+}
+
+// proxyLBCertificateCommonName stateの証明書からSNI名として利用するコモンネームを取得する
+func proxyLBCertificateCommonName(d resourceValueGettable) string {
+	if cert, err := parseProxyLBCertificate(d.Get("server_cert").(string)); err == nil {
+		return cert.Subject.CommonName
+	}
+	return d.Get("common_name").(string)
+}
+
+func findProxyLBAdditionalCert(certs []*iaas.ProxyLBAdditionalCert, commonName string) *iaas.ProxyLBAdditionalCert {
+	for _, cert := range certs {
+		if cert.CertificateCommonName == commonName {
+			return cert
+		}
+	}
+	return nil
+}
+
+func proxyLBCertificateID(proxyLBID, commonName string) string {
+	return fmt.Sprintf("%s/%s", proxyLBID, commonName)
+}
+
+// parseProxyLBCertificate PEM形式の証明書のうち先頭の証明書をパースする
+func parseProxyLBCertificate(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("server_cert must be a PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing server_cert is failed: %s", err)
+	}
+	if cert.Subject.CommonName == "" {
+		return nil, errors.New("server_cert must have a common name")
+	}
+	return cert, nil
+}
+
+func proxyLBCertificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// isProxyLBCertificateRenewalRequired 証明書の有効期限までの残りがrenewBeforeDays日以内か判定する
+func isProxyLBCertificateRenewalRequired(cert *x509.Certificate, renewBeforeDays int, now time.Time) bool {
+	return !now.AddDate(0, 0, renewBeforeDays).Before(cert.NotAfter)
+}
+
+// validateProxyLBCertificateKeyPair server_certとprivate_keyが対応しているか検証する
+func validateProxyLBCertificateKeyPair(config cty.Value) error {
+	certPEM, certKnown := ctyString(config, "server_cert")
+	keyPEM, keyKnown := ctyString(config, "private_key")
+	if !certKnown || !keyKnown || certPEM == "" || keyPEM == "" {
+		return nil
+	}
+	if _, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM)); err != nil {
+		return fmt.Errorf("private_key does not match server_cert: %s", err)
+	}
+	return nil
+}
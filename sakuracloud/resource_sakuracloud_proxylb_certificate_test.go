@@ -0,0 +1,173 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	envProxyLBCertificateCrt = "SAKURACLOUD_PROXYLB_CERT_PATH"
+	envProxyLBCertificateKey = "SAKURACLOUD_PROXYLB_KEY_PATH"
+)
+
+func testGenerateProxyLBCertificate(t *testing.T, commonName string, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName, "www." + commonName},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestParseProxyLBCertificate(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	certPEM, keyPEM := testGenerateProxyLBCertificate(t, "example.com", notAfter)
+
+	cert, err := parseProxyLBCertificate(certPEM)
+	require.NoError(t, err)
+	require.Equal(t, "example.com", cert.Subject.CommonName)
+	require.Equal(t, []string{"example.com", "www.example.com"}, cert.DNSNames)
+	require.True(t, notAfter.Equal(cert.NotAfter))
+	require.Regexp(t, regexp.MustCompile(`^[0-9a-f]{64}$`), proxyLBCertificateFingerprint(cert))
+
+	_, err = parseProxyLBCertificate("")
+	require.Error(t, err)
+	_, err = parseProxyLBCertificate(keyPEM)
+	require.Error(t, err)
+}
+
+func TestIsProxyLBCertificateRenewalRequired(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	certPEM, _ := testGenerateProxyLBCertificate(t, "example.com", now.AddDate(0, 0, 30))
+	cert, err := parseProxyLBCertificate(certPEM)
+	require.NoError(t, err)
+
+	require.False(t, isProxyLBCertificateRenewalRequired(cert, 29, now))
+	require.True(t, isProxyLBCertificateRenewalRequired(cert, 30, now))
+	require.True(t, isProxyLBCertificateRenewalRequired(cert, 31, now))
+	require.True(t, isProxyLBCertificateRenewalRequired(cert, 1, now.AddDate(0, 0, 31)))
+}
+
+func TestValidateProxyLBCertificateKeyPair(t *testing.T) {
+	notAfter := time.Now().AddDate(1, 0, 0)
+	certPEM, keyPEM := testGenerateProxyLBCertificate(t, "example.com", notAfter)
+	_, otherKeyPEM := testGenerateProxyLBCertificate(t, "example.com", notAfter)
+
+	config := func(cert, key cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"server_cert": cert,
+			"private_key": key,
+		})
+	}
+
+	require.NoError(t, validateProxyLBCertificateKeyPair(config(cty.StringVal(certPEM), cty.StringVal(keyPEM))))
+	require.NoError(t, validateProxyLBCertificateKeyPair(config(cty.StringVal(certPEM), cty.UnknownVal(cty.String))))
+	require.Error(t, validateProxyLBCertificateKeyPair(config(cty.StringVal(certPEM), cty.StringVal(otherKeyPEM))))
+}
+
+func TestAccSakuraCloudProxyLBCertificate_basic(t *testing.T) {
+	skipIfEnvIsNotSet(t, envProxyLBRealServerIP0, envProxyLBCertificateCrt, envProxyLBCertificateKey)
+
+	resourceName := "sakuracloud_proxylb_certificate.foobar"
+	rand := randomName()
+	ip0 := os.Getenv(envProxyLBRealServerIP0)
+	crt := os.Getenv(envProxyLBCertificateCrt)
+	key := os.Getenv(envProxyLBCertificateKey)
+
+	regexpNotEmpty := regexp.MustCompile(".+")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudProxyLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudProxyLBCertificate_basic, rand, ip0, crt, key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						resourceName, "proxylb_id",
+						"sakuracloud_proxylb.foobar", "id",
+					),
+					resource.TestMatchResourceAttr(resourceName, "common_name", regexpNotEmpty),
+					resource.TestMatchResourceAttr(resourceName, "not_after", regexpNotEmpty),
+					resource.TestMatchResourceAttr(resourceName, "sha256_fingerprint", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, "renew_before_days", "7"),
+					resource.TestCheckResourceAttr("sakuracloud_proxylb.foobar", "certificate.0.additional_certificate.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"renew_before_days"},
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudProxyLBCertificate_basic = `
+resource "sakuracloud_proxylb" "foobar" {
+  name = "{{ .arg0 }}"
+
+  health_check {
+    protocol   = "tcp"
+    delay_loop = 10
+  }
+
+  bind_port {
+    proxy_mode = "https"
+    port       = 443
+  }
+
+  server {
+    ip_address = "{{ .arg1 }}"
+    port       = 80
+  }
+}
+
+resource "sakuracloud_proxylb_certificate" "foobar" {
+  proxylb_id        = sakuracloud_proxylb.foobar.id
+  server_cert       = file("{{ .arg2 }}")
+  private_key       = file("{{ .arg3 }}")
+  renew_before_days = 7
+}
+`
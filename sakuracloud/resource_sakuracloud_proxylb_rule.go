@@ -0,0 +1,291 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+)
+
+func resourceSakuraCloudProxyLBRule() *schema.Resource {
+	s := schemaResourceProxyLBRule().Schema
+	s["proxylb_id"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+		Description:      "The id of the ProxyLB that set the rule to",
+	}
+	s["priority"] = &schema.Schema{
+		Type:             schema.TypeInt,
+		Required:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "The position of the rule in the rules of the ProxyLB, starting from 1. Rules are evaluated in ascending order of this value",
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceSakuraCloudProxyLBRuleCreate,
+		ReadContext:   resourceSakuraCloudProxyLBRuleRead,
+		UpdateContext: resourceSakuraCloudProxyLBRuleUpdate,
+		DeleteContext: resourceSakuraCloudProxyLBRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSakuraCloudProxyLBRuleImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceSakuraCloudProxyLBRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	proxyLBID := d.Get("proxylb_id").(string)
+	priority := d.Get("priority").(int)
+	rule := expandProxyLBRule(d)
+
+	// 同じ実行内で作成される他のルールの順序に依存しないよう、priorityより前の位置のルールが揃ってから挿入する
+	if err := waitForProxyLBRulePosition(ctx, iaas.NewProxyLBOp(client), proxyLBID, priority); err != nil {
+		return diag.Errorf("creating SakuraCloud ProxyLB Rule is failed: ProxyLB[%s]: %s", proxyLBID, err)
+	}
+
+	err = updateProxyLBSettings(ctx, d, meta, func(proxyLB *iaas.ProxyLB) error {
+		if findProxyLBRule(proxyLB.Rules, rule) >= 0 {
+			return fmt.Errorf("same rule already exists")
+		}
+		proxyLB.Rules = insertProxyLBRule(proxyLB.Rules, priority, rule)
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("creating SakuraCloud ProxyLB Rule is failed: ProxyLB[%s]: %s", proxyLBID, err)
+	}
+
+	d.SetId(proxyLBRuleID(proxyLBID, priority))
+	return resourceSakuraCloudProxyLBRuleRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudProxyLBRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	proxyLBID := d.Get("proxylb_id").(string)
+	proxyLB, err := iaas.NewProxyLBOp(client).Read(ctx, sakuraCloudID(proxyLBID))
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read SakuraCloud ProxyLB[%s]: %s", proxyLBID, err)
+	}
+
+	index := findProxyLBRule(proxyLB.Rules, expandProxyLBRule(d))
+	if index < 0 {
+		d.SetId("")
+		return nil
+	}
+	rule := proxyLB.Rules[index]
+
+	d.Set("proxylb_id", proxyLB.ID.String()) //nolint
+	d.Set("priority", index+1)               //nolint
+	for k, v := range flattenProxyLBRule(rule) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceSakuraCloudProxyLBRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	proxyLBID := d.Get("proxylb_id").(string)
+	priority := d.Get("priority").(int)
+	old, rule := expandProxyLBRuleChange(d)
+
+	err := updateProxyLBSettings(ctx, d, meta, func(proxyLB *iaas.ProxyLB) error {
+		index := findProxyLBRule(proxyLB.Rules, old)
+		if index < 0 {
+			return fmt.Errorf("rule is not found")
+		}
+		rules := append(proxyLB.Rules[:index:index], proxyLB.Rules[index+1:]...)
+		if findProxyLBRule(rules, rule) >= 0 {
+			return fmt.Errorf("same rule already exists")
+		}
+		proxyLB.Rules = insertProxyLBRule(rules, priority, rule)
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("updating SakuraCloud ProxyLB Rule is failed: ProxyLB[%s]: %s", proxyLBID, err)
+	}
+
+	return resourceSakuraCloudProxyLBRuleRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudProxyLBRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	proxyLBID := d.Get("proxylb_id").(string)
+	rule := expandProxyLBRule(d)
+
+	err := updateProxyLBSettings(ctx, d, meta, func(proxyLB *iaas.ProxyLB) error {
+		if index := findProxyLBRule(proxyLB.Rules, rule); index >= 0 {
+			proxyLB.Rules = append(proxyLB.Rules[:index], proxyLB.Rules[index+1:]...)
+		}
+		return nil
+	})
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("deleting SakuraCloud ProxyLB Rule is failed: ProxyLB[%s]: %s", proxyLBID, err)
+	}
+
+	return nil
+}
+
+// resourceSakuraCloudProxyLBRuleImport `<proxylb_id>/<priority>`形式のIDでインポートする
+func resourceSakuraCloudProxyLBRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*APIClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import id %q: expected format is <proxylb_id>/<priority>", d.Id())
+	}
+	proxyLBID := parts[0]
+	priority, err := strconv.Atoi(parts[1])
+	if err != nil || priority < 1 {
+		return nil, fmt.Errorf("invalid import id %q: priority must be a positive number", d.Id())
+	}
+
+	proxyLB, err := iaas.NewProxyLBOp(client).Read(ctx, sakuraCloudID(proxyLBID))
+	if err != nil {
+		return nil, fmt.Errorf("could not read SakuraCloud ProxyLB[%s]: %s", proxyLBID, err)
+	}
+	if len(proxyLB.Rules) < priority {
+		return nil, fmt.Errorf("rule with priority %d is not found in SakuraCloud ProxyLB[%s]", priority, proxyLBID)
+	}
+	rule := proxyLB.Rules[priority-1]
+
+	d.Set("proxylb_id", proxyLBID) //nolint:errcheck,gosec
+	d.Set("priority", priority)    //nolint:errcheck,gosec
+	for k, v := range flattenProxyLBRule(rule) {
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+	d.SetId(proxyLBRuleID(proxyLBID, priority))
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandProxyLBRuleChange 変更前と変更後のルールを返す
+func expandProxyLBRuleChange(d *schema.ResourceData) (*iaas.ProxyLBRule, *iaas.ProxyLBRule) {
+	old := map[string]interface{}{}
+	for k := range schemaResourceProxyLBRule().Schema {
+		o, _ := d.GetChange(k)
+		old[k] = o
+	}
+	return expandProxyLBRule(mapToResourceData(old)), expandProxyLBRule(d)
+}
+
+// findProxyLBRule rulesの中からruleと同じ内容のルールを探しインデックスを返す、見つからない場合は-1を返す
+func findProxyLBRule(rules []*iaas.ProxyLBRule, rule *iaas.ProxyLBRule) int {
+	for i, r := range rules {
+		if isSameProxyLBRule(r, rule) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isSameProxyLBRule(r1, r2 *iaas.ProxyLBRule) bool {
+	normalize := func(r *iaas.ProxyLBRule) iaas.ProxyLBRule {
+		v := *r
+		if v.Action == "" {
+			v.Action = types.ProxyLBRuleActions.Forward
+		}
+		return v
+	}
+	return reflect.DeepEqual(normalize(r1), normalize(r2))
+}
+
+// insertProxyLBRule priority(1から始まる位置)にruleを挿入する、priorityがルールの数を超える場合は末尾に追加する
+func insertProxyLBRule(rules []*iaas.ProxyLBRule, priority int, rule *iaas.ProxyLBRule) []*iaas.ProxyLBRule {
+	index := min(priority-1, len(rules))
+
+	var results []*iaas.ProxyLBRule
+	results = append(results, rules[:index]...)
+	results = append(results, rule)
+	results = append(results, rules[index:]...)
+	return results
+}
+
+var (
+	// proxyLBRulePollingInterval 前の位置のルールが設定されたか確認する間隔
+	proxyLBRulePollingInterval = 5 * time.Second
+	// proxyLBRuleStallTimeout ルールの数が増えないまま待ち続ける最大の時間
+	proxyLBRuleStallTimeout = 2 * time.Minute
+)
+
+// waitForProxyLBRulePosition priorityより前の位置のルールがProxyLBに設定されるまで待つ
+//
+// priorityの誤りで待ち続けないよう、ルールの数がproxyLBRuleStallTimeoutの間増えなかった場合はエラーとする
+func waitForProxyLBRulePosition(ctx context.Context, proxyLBOp iaas.ProxyLBAPI, proxyLBID string, priority int) error {
+	lastCount := -1
+	lastProgress := time.Now()
+	for {
+		proxyLB, err := proxyLBOp.Read(ctx, sakuraCloudID(proxyLBID))
+		if err != nil {
+			return err
+		}
+		count := len(proxyLB.Rules)
+		if count >= priority-1 {
+			return nil
+		}
+		if count > lastCount {
+			lastCount = count
+			lastProgress = time.Now()
+		}
+		if time.Since(lastProgress) >= proxyLBRuleStallTimeout {
+			return fmt.Errorf("priority %d requires %d rules before it, but the ProxyLB has only %d rules and no rule has been added for %s", priority, priority-1, count, proxyLBRuleStallTimeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("priority %d requires %d rules before it, but the ProxyLB has only %d rules: %s", priority, priority-1, count, ctx.Err())
+		case <-time.After(proxyLBRulePollingInterval):
+		}
+	}
+}
+
+func proxyLBRuleID(proxyLBID string, priority int) string {
+	return fmt.Sprintf("%s/%d", proxyLBID, priority)
+}
@@ -0,0 +1,241 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertProxyLBRule(t *testing.T) {
+	rule1 := &iaas.ProxyLBRule{Host: "www1.example.com", ServerGroup: "group1"}
+	rule2 := &iaas.ProxyLBRule{Host: "www2.example.com", ServerGroup: "group2"}
+	newRule := &iaas.ProxyLBRule{Host: "new.example.com", ServerGroup: "group5"}
+
+	cases := []struct {
+		msg      string
+		rules    []*iaas.ProxyLBRule
+		priority int
+		want     []*iaas.ProxyLBRule
+	}{
+		{
+			msg:      "empty",
+			priority: 1,
+			want:     []*iaas.ProxyLBRule{newRule},
+		},
+		{
+			msg:      "first",
+			rules:    []*iaas.ProxyLBRule{rule1, rule2},
+			priority: 1,
+			want:     []*iaas.ProxyLBRule{newRule, rule1, rule2},
+		},
+		{
+			msg:      "middle",
+			rules:    []*iaas.ProxyLBRule{rule1, rule2},
+			priority: 2,
+			want:     []*iaas.ProxyLBRule{rule1, newRule, rule2},
+		},
+		{
+			msg:      "last",
+			rules:    []*iaas.ProxyLBRule{rule1, rule2},
+			priority: 3,
+			want:     []*iaas.ProxyLBRule{rule1, rule2, newRule},
+		},
+		{
+			msg:      "exceeds the number of rules",
+			rules:    []*iaas.ProxyLBRule{rule1, rule2},
+			priority: 10,
+			want:     []*iaas.ProxyLBRule{rule1, rule2, newRule},
+		},
+	}
+
+	for _, tc := range cases {
+		got := insertProxyLBRule(tc.rules, tc.priority, newRule)
+		require.Equal(t, tc.want, got, tc.msg)
+	}
+}
+
+func TestIsSameProxyLBRule(t *testing.T) {
+	require.True(t, isSameProxyLBRule(
+		&iaas.ProxyLBRule{Host: "www.example.com", ServerGroup: "group1"},
+		&iaas.ProxyLBRule{Host: "www.example.com", ServerGroup: "group1", Action: "forward"},
+	))
+	require.False(t, isSameProxyLBRule(
+		&iaas.ProxyLBRule{Host: "www.example.com", ServerGroup: "group1"},
+		&iaas.ProxyLBRule{Host: "www.example.com", ServerGroup: "group2"},
+	))
+}
+
+type dummyProxyLBRulesReader struct {
+	iaas.ProxyLBAPI
+	rules int
+}
+
+func (r *dummyProxyLBRulesReader) Read(_ context.Context, _ types.ID) (*iaas.ProxyLB, error) {
+	return &iaas.ProxyLB{Rules: make([]*iaas.ProxyLBRule, r.rules)}, nil
+}
+
+func TestWaitForProxyLBRulePosition(t *testing.T) {
+	defer func(interval, timeout time.Duration) {
+		proxyLBRulePollingInterval, proxyLBRuleStallTimeout = interval, timeout
+	}(proxyLBRulePollingInterval, proxyLBRuleStallTimeout)
+	proxyLBRulePollingInterval = 10 * time.Millisecond
+	proxyLBRuleStallTimeout = 50 * time.Millisecond
+
+	ctx := context.Background()
+	reader := &dummyProxyLBRulesReader{rules: 1}
+	require.NoError(t, waitForProxyLBRulePosition(ctx, reader, "123456789012", 1))
+	require.NoError(t, waitForProxyLBRulePosition(ctx, reader, "123456789012", 2))
+
+	// ルールの数が増えない場合はタイムアウトを待たずにエラーとする
+	err := waitForProxyLBRulePosition(ctx, reader, "123456789012", 3)
+	require.ErrorContains(t, err, "priority 3 requires 2 rules before it, but the ProxyLB has only 1 rules and no rule has been added")
+}
+
+func TestAccSakuraCloudProxyLBRule_basic(t *testing.T) {
+	skipIfEnvIsNotSet(t, envProxyLBRealServerIP0)
+
+	resourceName := "sakuracloud_proxylb_rule.foobar1"
+	rand := randomName()
+	ip0 := os.Getenv(envProxyLBRealServerIP0)
+
+	var proxylb iaas.ProxyLB
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudProxyLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudProxyLBRule_basic, rand, ip0),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudProxyLBExists("sakuracloud_proxylb.foobar", &proxylb),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "proxylb_id",
+						"sakuracloud_proxylb.foobar", "id",
+					),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "host", "www1.usacloud.jp"),
+					resource.TestCheckResourceAttr(resourceName, "group", "group1"),
+					resource.TestCheckResourceAttr(resourceName, "action", "forward"),
+					resource.TestCheckResourceAttr("sakuracloud_proxylb_rule.foobar3", "priority", "2"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudProxyLBRule_update, rand, ip0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "host", "www1.usacloud.jp"),
+					resource.TestCheckResourceAttr(resourceName, "path", "/api"),
+					resource.TestCheckResourceAttr("sakuracloud_proxylb.foobar", "rule.#", "3"),
+					resource.TestCheckResourceAttr("sakuracloud_proxylb.foobar", "rule.0.host", "www1.usacloud.jp"),
+					resource.TestCheckResourceAttr("sakuracloud_proxylb.foobar", "rule.1.host", "www2.usacloud.jp"),
+					resource.TestCheckResourceAttr("sakuracloud_proxylb.foobar", "rule.2.host", "www3.usacloud.jp"),
+					resource.TestCheckResourceAttr("sakuracloud_proxylb_rule.foobar3", "priority", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudProxyLBRule_basic = `
+resource "sakuracloud_proxylb" "foobar" {
+  name = "{{ .arg0 }}"
+
+  health_check {
+    protocol   = "tcp"
+    delay_loop = 10
+  }
+
+  bind_port {
+    proxy_mode = "http"
+    port       = 80
+  }
+
+  server {
+    ip_address = "{{ .arg1 }}"
+    port       = 80
+    group      = "group1"
+  }
+}
+
+resource "sakuracloud_proxylb_rule" "foobar1" {
+  proxylb_id = sakuracloud_proxylb.foobar.id
+  priority   = 1
+  host       = "www1.usacloud.jp"
+  group      = "group1"
+}
+
+resource "sakuracloud_proxylb_rule" "foobar3" {
+  proxylb_id = sakuracloud_proxylb.foobar.id
+  priority   = 2
+  host       = "www3.usacloud.jp"
+  group      = "group1"
+}
+`
+
+var testAccSakuraCloudProxyLBRule_update = `
+resource "sakuracloud_proxylb" "foobar" {
+  name = "{{ .arg0 }}"
+
+  health_check {
+    protocol   = "tcp"
+    delay_loop = 10
+  }
+
+  bind_port {
+    proxy_mode = "http"
+    port       = 80
+  }
+
+  server {
+    ip_address = "{{ .arg1 }}"
+    port       = 80
+    group      = "group1"
+  }
+}
+
+resource "sakuracloud_proxylb_rule" "foobar1" {
+  proxylb_id = sakuracloud_proxylb.foobar.id
+  priority   = 1
+  host       = "www1.usacloud.jp"
+  path       = "/api"
+  group      = "group1"
+}
+
+resource "sakuracloud_proxylb_rule" "foobar2" {
+  proxylb_id = sakuracloud_proxylb.foobar.id
+  priority   = 2
+  host       = "www2.usacloud.jp"
+  group      = "group1"
+}
+
+resource "sakuracloud_proxylb_rule" "foobar3" {
+  proxylb_id = sakuracloud_proxylb.foobar.id
+  priority   = 3
+  host       = "www3.usacloud.jp"
+  group      = "group1"
+}
+`
@@ -0,0 +1,191 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func resourceSakuraCloudProxyLBServer() *schema.Resource {
+	s := schemaResourceProxyLBServer().Schema
+	s["ip_address"].ForceNew = true
+	s["port"].ForceNew = true
+	s["proxylb_id"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+		Description:      "The id of the ProxyLB that set the server to",
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceSakuraCloudProxyLBServerCreate,
+		ReadContext:   resourceSakuraCloudProxyLBServerRead,
+		UpdateContext: resourceSakuraCloudProxyLBServerUpdate,
+		DeleteContext: resourceSakuraCloudProxyLBServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSakuraCloudProxyLBServerImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceSakuraCloudProxyLBServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	proxyLBID := d.Get("proxylb_id").(string)
+	server := expandProxyLBServer(d)
+
+	err := updateProxyLBSettings(ctx, d, meta, func(proxyLB *iaas.ProxyLB) error {
+		if findProxyLBServer(proxyLB.Servers, server.IPAddress, server.Port) != nil {
+			return fmt.Errorf("server %s:%d already exists", server.IPAddress, server.Port)
+		}
+		proxyLB.Servers = append(proxyLB.Servers, server)
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("creating SakuraCloud ProxyLB Server is failed: ProxyLB[%s]: %s", proxyLBID, err)
+	}
+
+	d.SetId(proxyLBServerID(proxyLBID, server.IPAddress, server.Port))
+	return resourceSakuraCloudProxyLBServerRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudProxyLBServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, _, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	proxyLBID := d.Get("proxylb_id").(string)
+	proxyLB, err := iaas.NewProxyLBOp(client).Read(ctx, sakuraCloudID(proxyLBID))
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read SakuraCloud ProxyLB[%s]: %s", proxyLBID, err)
+	}
+
+	server := findProxyLBServer(proxyLB.Servers, d.Get("ip_address").(string), d.Get("port").(int))
+	if server == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("proxylb_id", proxyLB.ID.String()) //nolint
+	for k, v := range flattenProxyLBServer(server) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceSakuraCloudProxyLBServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	proxyLBID := d.Get("proxylb_id").(string)
+	server := expandProxyLBServer(d)
+
+	err := updateProxyLBSettings(ctx, d, meta, func(proxyLB *iaas.ProxyLB) error {
+		current := findProxyLBServer(proxyLB.Servers, server.IPAddress, server.Port)
+		if current == nil {
+			return fmt.Errorf("server %s:%d is not found", server.IPAddress, server.Port)
+		}
+		current.ServerGroup = server.ServerGroup
+		current.Enabled = server.Enabled
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("updating SakuraCloud ProxyLB Server is failed: ProxyLB[%s]: %s", proxyLBID, err)
+	}
+	return resourceSakuraCloudProxyLBServerRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudProxyLBServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	proxyLBID := d.Get("proxylb_id").(string)
+	ipAddress := d.Get("ip_address").(string)
+	port := d.Get("port").(int)
+
+	err := updateProxyLBSettings(ctx, d, meta, func(proxyLB *iaas.ProxyLB) error {
+		var servers []*iaas.ProxyLBServer
+		for _, s := range proxyLB.Servers {
+			if s.IPAddress == ipAddress && s.Port == port {
+				continue
+			}
+			servers = append(servers, s)
+		}
+		proxyLB.Servers = servers
+		return nil
+	})
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("deleting SakuraCloud ProxyLB Server is failed: ProxyLB[%s]: %s", proxyLBID, err)
+	}
+	return nil
+}
+
+// resourceSakuraCloudProxyLBServerImport `<proxylb_id>/<ip_address>/<port>`形式のIDでインポートする
+func resourceSakuraCloudProxyLBServerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid import id %q: expected format is <proxylb_id>/<ip_address>/<port>", d.Id())
+	}
+	port, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid import id %q: port must be a number", d.Id())
+	}
+
+	d.Set("proxylb_id", parts[0]) //nolint:errcheck,gosec
+	d.Set("ip_address", parts[1]) //nolint:errcheck,gosec
+	d.Set("port", port)           //nolint:errcheck,gosec
+
+	if diags := resourceSakuraCloudProxyLBServerRead(ctx, d, meta); diags.HasError() {
+		return nil, fmt.Errorf("could not read SakuraCloud ProxyLB Server[%s]: %v", d.Id(), diags)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("server %s:%d is not found in SakuraCloud ProxyLB[%s]", parts[1], port, parts[0])
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func findProxyLBServer(servers []*iaas.ProxyLBServer, ipAddress string, port int) *iaas.ProxyLBServer {
+	for _, s := range servers {
+		if s.IPAddress == ipAddress && s.Port == port {
+			return s
+		}
+	}
+	return nil
+}
+
+func proxyLBServerID(proxyLBID, ipAddress string, port int) string {
+	return fmt.Sprintf("%s/%s/%d", proxyLBID, ipAddress, port)
+}
@@ -0,0 +1,126 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func TestAccSakuraCloudProxyLBServer_basic(t *testing.T) {
+	skipIfEnvIsNotSet(t, envProxyLBRealServerIP0, envProxyLBRealServerIP1)
+
+	resourceName := "sakuracloud_proxylb_server.foobar"
+	rand := randomName()
+	ip0 := os.Getenv(envProxyLBRealServerIP0)
+	ip1 := os.Getenv(envProxyLBRealServerIP1)
+
+	var proxylb iaas.ProxyLB
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudProxyLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudProxyLBServer_basic, rand, ip0, ip1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudProxyLBExists("sakuracloud_proxylb.foobar", &proxylb),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "proxylb_id",
+						"sakuracloud_proxylb.foobar", "id",
+					),
+					resource.TestCheckResourceAttr(resourceName, "ip_address", ip1),
+					resource.TestCheckResourceAttr(resourceName, "port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "group", "group1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudProxyLBServer_update, rand, ip0, ip1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ip_address", ip1),
+					resource.TestCheckResourceAttr(resourceName, "group", "group2"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr("sakuracloud_proxylb.foobar", "server.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudProxyLBServer_basic = `
+resource "sakuracloud_proxylb" "foobar" {
+  name = "{{ .arg0 }}"
+
+  health_check {
+    protocol   = "tcp"
+    delay_loop = 10
+  }
+
+  bind_port {
+    proxy_mode = "http"
+    port       = 80
+  }
+
+  server {
+    ip_address = "{{ .arg1 }}"
+    port       = 80
+  }
+}
+
+resource "sakuracloud_proxylb_server" "foobar" {
+  proxylb_id = sakuracloud_proxylb.foobar.id
+  ip_address = "{{ .arg2 }}"
+  port       = 80
+  group      = "group1"
+}
+`
+
+var testAccSakuraCloudProxyLBServer_update = `
+resource "sakuracloud_proxylb" "foobar" {
+  name = "{{ .arg0 }}"
+
+  health_check {
+    protocol   = "tcp"
+    delay_loop = 10
+  }
+
+  bind_port {
+    proxy_mode = "http"
+    port       = 80
+  }
+
+  server {
+    ip_address = "{{ .arg1 }}"
+    port       = 80
+  }
+}
+
+resource "sakuracloud_proxylb_server" "foobar" {
+  proxylb_id = sakuracloud_proxylb.foobar.id
+  ip_address = "{{ .arg2 }}"
+  port       = 80
+  group      = "group2"
+  enabled    = false
+}
+`
@@ -16,6 +16,7 @@ package sakuracloud
 
 import (
 	"context"
+	"errors"
 	"slices"
 	"time"
 
@@ -32,8 +33,7 @@ func resourceSakuraCloudSecretManagerSecret() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSakuraCloudSecretManagerSecretCreate,
 		ReadContext:   resourceSakuraCloudSecretManagerSecretRead,
-		//UpdateContext: resourceSakuraCloudSecretManagerSecretUpdate,
-		UpdateContext: resourceSakuraCloudSecretManagerSecretCreate,
+		UpdateContext: resourceSakuraCloudSecretManagerSecretUpdate,
 		DeleteContext: resourceSakuraCloudSecretManagerSecretDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -55,6 +55,7 @@ func resourceSakuraCloudSecretManagerSecret() *schema.Resource {
 			"vault_id": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: desc.Sprintf("The secret manager's vault id of the %s", resourceName),
 			},
 			"version": {
@@ -63,10 +64,25 @@ func resourceSakuraCloudSecretManagerSecret() *schema.Resource {
 				Description: "Version of secret value. This value is incremented by create/update",
 			},
 			"value": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Secret value",
-				Sensitive:   true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"value", "value_wo"},
+				Description:  "Secret value",
+				Sensitive:    true,
+			},
+			"value_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				RequiredWith: []string{"value_wo_version"},
+				Description:  "Secret value. This value is write-only and is never stored in the plan or state. Requires Terraform v1.11 or later",
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
+				Description:  "The version of `value_wo`. Changing this value pushes `value_wo` as a new version of the secret",
 			},
 		},
 	}
@@ -81,7 +97,12 @@ func resourceSakuraCloudSecretManagerSecretCreate(ctx context.Context, d *schema
 	id := d.Get("vault_id").(string)
 	secretOp := sm.NewSecretOp(client.secretmanagerClient, id)
 
-	createdSec, err := secretOp.Create(ctx, expandSecretManagerCreateSecret(d))
+	req, diags := expandSecretManagerCreateSecret(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	createdSec, err := secretOp.Create(ctx, req)
 	if err != nil {
 		return diag.Errorf("could not create SecretManagerSecret secret: %s", err)
 	}
@@ -105,12 +126,54 @@ func resourceSakuraCloudSecretManagerSecretRead(ctx context.Context, d *schema.R
 
 	secret, err := filterSecretManagerSecretByName(d, ctx, secretOp, name)
 	if err != nil {
+		if errors.Is(err, errFilterNoResult) {
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("could not read SecretManagerSecret[%s] secret: %s", name, err)
 	}
 
+	// Terraform外で新しいバージョンが登録された場合はvalueの差分として検出できるように最新の値を取得しておく
+	// value_woを利用している場合は値をstateに保持しないため対象外
+	if _, ok := d.GetOk("value"); ok && secret.LatestVersion != d.Get("version").(int) {
+		unveil, err := secretOp.Unveil(ctx, v1.Unveil{Name: name, Version: v1.NewOptNilInt(secret.LatestVersion)})
+		if err != nil {
+			return diag.Errorf("could not unveil SecretManagerSecret[%s] secret: %s", name, err)
+		}
+		d.Set("value", unveil.Value) //nolint:errcheck,gosec
+	}
+
 	return setSecretManagerSecretResourceData(d, secret)
 }
 
+func resourceSakuraCloudSecretManagerSecretUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client, _, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	id := d.Get("vault_id").(string)
+	secretOp := sm.NewSecretOp(client.secretmanagerClient, id)
+
+	// name/vault_idはForceNewのため、ここでは値が変更された場合に新しいバージョンを登録するのみ
+	if !d.HasChanges("value", "value_wo_version") {
+		return nil
+	}
+
+	req, diags := expandSecretManagerCreateSecret(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	updated, err := secretOp.Update(ctx, req)
+	if err != nil {
+		return diag.Errorf("could not update SecretManagerSecret[%s] secret: %s", name, err)
+	}
+
+	return setSecretManagerSecretResourceData(d, updated)
+}
+
 func resourceSakuraCloudSecretManagerSecretDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client, _, err := sakuraCloudClient(d, meta)
 	if err != nil {
@@ -61,6 +61,43 @@ func TestAccSakuraCloudSecretManagerSecret_basic(t *testing.T) {
 	})
 }
 
+func TestAccSakuraCloudSecretManagerSecret_writeOnly(t *testing.T) {
+	skipIfFakeModeEnabled(t)
+
+	resourceName := "sakuracloud_secret_manager_secret.foobar"
+	rand := randomName()
+
+	var secret v1.Secret
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories,
+		CheckDestroy:             testCheckSakuraCloudSecretManagerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudSecretManagerSecret_writeOnly, rand, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudSecretManagerSecretExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "name", rand),
+					resource.TestCheckNoResourceAttr(resourceName, "value"),
+					resource.TestCheckNoResourceAttr(resourceName, "value_wo"),
+					resource.TestCheckResourceAttr(resourceName, "value_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(resourceName+"_copy", "version", "1"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudSecretManagerSecret_writeOnly, rand, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudSecretManagerSecretExists(resourceName, &secret),
+					resource.TestCheckNoResourceAttr(resourceName, "value_wo"),
+					resource.TestCheckResourceAttr(resourceName, "value_wo_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckSakuraCloudSecretManagerSecretDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*APIClient)
 	rd := &schema.ResourceData{}
@@ -160,3 +197,40 @@ resource "sakuracloud_secret_manager_secret" "foobar" {
 
   depends_on = [sakuracloud_secret_manager.foobar]
 }`
+
+//nolint:gosec
+var testAccSakuraCloudSecretManagerSecret_writeOnly = `
+resource "sakuracloud_kms" "foobar" {
+  name        = "{{ .arg0 }}"
+  description = "description"
+}
+
+resource "sakuracloud_secret_manager" "foobar" {
+  name        = "{{ .arg0 }}"
+  description = "description"
+  kms_key_id  = sakuracloud_kms.foobar.id
+
+  depends_on = [sakuracloud_kms.foobar]
+}
+
+resource "sakuracloud_secret_manager_secret" "foobar" {
+  name             = "{{ .arg0 }}"
+  value_wo         = "value{{ .arg1 }}"
+  value_wo_version = {{ .arg1 }}
+  vault_id         = sakuracloud_secret_manager.foobar.id
+
+  depends_on = [sakuracloud_secret_manager.foobar]
+}
+
+ephemeral "sakuracloud_secret_manager_secret" "foobar" {
+  name     = sakuracloud_secret_manager_secret.foobar.name
+  vault_id = sakuracloud_secret_manager.foobar.id
+  version  = sakuracloud_secret_manager_secret.foobar.version
+}
+
+resource "sakuracloud_secret_manager_secret" "foobar_copy" {
+  name             = "{{ .arg0 }}-copy"
+  value_wo         = ephemeral.sakuracloud_secret_manager_secret.foobar.value
+  value_wo_version = 1
+  vault_id         = sakuracloud_secret_manager.foobar.id
+}`
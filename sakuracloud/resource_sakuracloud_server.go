@@ -37,7 +37,7 @@ func resourceSakuraCloudServer() *schema.Resource {
 		ReadContext:   resourceSakuraCloudServerRead,
 		DeleteContext: resourceSakuraCloudServerDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -311,6 +311,16 @@ func resourceSakuraCloudServer() *schema.Resource {
 				Optional:    true,
 				Description: "The flag to use force shutdown when need to reboot/shutdown while applying",
 			},
+			"power_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(serverPowerStates, false)),
+				Description: desc.Sprintf(
+					"The desired power state of the Server. This must be one of [%s]. The provider boots or shuts down the Server on every apply to match this value",
+					serverPowerStates,
+				),
+			},
 		},
 	}
 }
@@ -374,6 +384,13 @@ func resourceSakuraCloudServerUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("could not read SakuraCloud Server[%s]: %s", d.Id(), err)
 	}
 
+	// 停止させる場合はbuilderによる再起動を避けるため先にシャットダウンしておく
+	if expandServerPowerState(d) == serverPowerStateDown && server.InstanceStatus.IsUp() {
+		if err := power.ShutdownServer(ctx, serverOp, zone, server.ID, d.Get("force_shutdown").(bool)); err != nil {
+			return diag.Errorf("stopping SakuraCloud Server[%s] is failed: %s", server.ID, err)
+		}
+	}
+
 	builder, err := expandServerBuilder(ctx, zone, d, client)
 	if err != nil {
 		return diag.FromErr(err)
@@ -388,6 +405,18 @@ func resourceSakuraCloudServerUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("updating SakuraCloud Server[%s] is failed: %s", server.ID, err)
 	}
 
+	if expandServerPowerState(d) == serverPowerStateUp {
+		updated, err := serverOp.Read(ctx, zone, result.ServerID)
+		if err != nil {
+			return diag.Errorf("could not read SakuraCloud Server[%s]: %s", result.ServerID, err)
+		}
+		if updated.InstanceStatus.IsDown() {
+			if err := power.BootServer(ctx, serverOp, zone, updated.ID); err != nil {
+				return diag.Errorf("booting SakuraCloud Server[%s] is failed: %s", updated.ID, err)
+			}
+		}
+	}
+
 	d.SetId(result.ServerID.String())
 	return resourceSakuraCloudServerRead(ctx, d, meta)
 }
@@ -471,6 +500,7 @@ func setServerResourceData(ctx context.Context, d *schema.ResourceData, client *
 	if err := d.Set("dns_servers", data.Zone.Region.NameServers); err != nil {
 		return diag.FromErr(err)
 	}
-	d.Set("zone", zone) //nolint
+	d.Set("power_state", flattenServerPowerState(data)) //nolint
+	d.Set("zone", zone)                                 //nolint
 	return diag.FromErr(d.Set("tags", flattenTags(data.Tags)))
 }
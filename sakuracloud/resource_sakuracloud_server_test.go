@@ -404,6 +404,53 @@ func TestAccSakuraCloudServer_withBlankDisk(t *testing.T) {
 	})
 }
 
+func TestAccSakuraCloudServer_powerState(t *testing.T) {
+	resourceName := "sakuracloud_server.foobar"
+	rand := randomName()
+
+	var server iaas.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudServer_powerState, rand, "down"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudServerExists(resourceName, &server),
+					func(*terraform.State) error {
+						if !server.InstanceStatus.IsDown() {
+							return fmt.Errorf("unexpected server status: got %q", server.InstanceStatus)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, "power_state", "down"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudServer_powerState, rand, "up"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudServerExists(resourceName, &server),
+					func(*terraform.State) error {
+						if !server.InstanceStatus.IsUp() {
+							return fmt.Errorf("unexpected server status: got %q", server.InstanceStatus)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, "power_state", "up"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudServer_powerState, rand, "down"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudServerExists(resourceName, &server),
+					resource.TestCheckResourceAttr(resourceName, "power_state", "down"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSakuraCloudServer_switch(t *testing.T) {
 	resourceName := "sakuracloud_server.foobar"
 	rand := randomName()
@@ -914,6 +961,22 @@ resource "sakuracloud_disk" "foobar" {
 }
 `
 
+const testAccSakuraCloudServer_powerState = `
+resource "sakuracloud_server" "foobar" {
+  name  = "{{ .arg0 }}"
+  disks = [sakuracloud_disk.foobar.id]
+
+  network_interface {
+    upstream = "shared"
+  }
+  power_state    = "{{ .arg1 }}"
+  force_shutdown = true
+}
+resource "sakuracloud_disk" "foobar" {
+  name = "{{ .arg0 }}"
+}
+`
+
 const testAccSakuraCloudServer_switch = `
 data "sakuracloud_archive" "ubuntu" {
   os_type = "ubuntu"
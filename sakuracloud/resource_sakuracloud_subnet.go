@@ -33,7 +33,7 @@ func resourceSakuraCloudSubnet() *schema.Resource {
 		UpdateContext: resourceSakuraCloudSubnetUpdate,
 		DeleteContext: resourceSakuraCloudSubnetDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -34,7 +34,7 @@ func resourceSakuraCloudSwitch() *schema.Resource {
 		UpdateContext: resourceSakuraCloudSwitchUpdate,
 		DeleteContext: resourceSakuraCloudSwitchDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/sacloud/iaas-api-go"
@@ -35,7 +36,14 @@ func resourceSakuraCloudVPCRouter() *schema.Resource {
 		UpdateContext: resourceSakuraCloudVPCRouterUpdate,
 		DeleteContext: resourceSakuraCloudVPCRouterDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importZonalResourceStateContext,
+		},
+		CustomizeDiff: customdiff.All(
+			resourceSakuraCloudVPCRouterFirewallCustomizeDiff,
+			resourceSakuraCloudVPCRouterWireGuardCustomizeDiff,
+		),
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			resourceSakuraCloudVPCRouterFirewallValidateRawConfig,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -189,20 +197,8 @@ func resourceSakuraCloudVPCRouter() *schema.Resource {
 			"dhcp_static_mapping": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"ip_address": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The static IP address to assign to DHCP client",
-						},
-						"mac_address": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The source MAC address of static mapping",
-						},
-					},
-				},
+				Computed: true,
+				Elem:     schemaResourceVPCRouterDHCPStaticMapping(),
 			},
 			"dns_forwarding": {
 				Type:     schema.TypeList,
@@ -231,81 +227,8 @@ func resourceSakuraCloudVPCRouter() *schema.Resource {
 			"firewall": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"interface_index": {
-							Type:             schema.TypeInt,
-							Optional:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 7)),
-							Description: desc.Sprintf(
-								"The index of the network interface on which to enable filtering. %s",
-								desc.Range(0, 7),
-							),
-						},
-						"direction": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"send", "receive"}, false)),
-							Description: desc.Sprintf(
-								"The direction to apply the firewall. This must be one of [%s]",
-								[]string{"send", "receive"},
-							),
-						},
-						"expression": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"protocol": {
-										Type:             schema.TypeString,
-										Required:         true,
-										ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.VPCRouterFirewallProtocolStrings, false)),
-										Description: desc.Sprintf(
-											"The protocol used for filtering. This must be one of [%s]",
-											types.VPCRouterFirewallProtocolStrings,
-										),
-									},
-									"source_network": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "A source IP address or CIDR block used for filtering (e.g. `192.0.2.1`, `192.0.2.0/24`)",
-									},
-									"source_port": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "A source port number or port range used for filtering (e.g. `1024`, `1024-2048`). This is only used when `protocol` is `tcp` or `udp`",
-									},
-									"destination_network": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "A destination IP address or CIDR block used for filtering (e.g. `192.0.2.1`, `192.0.2.0/24`)",
-									},
-									"destination_port": {
-										Type:        schema.TypeString,
-										Optional:    true,
-										Description: "A destination port number or port range used for filtering (e.g. `1024`, `1024-2048`). This is only used when `protocol` is `tcp` or `udp`",
-									},
-									"allow": {
-										Type:        schema.TypeBool,
-										Required:    true,
-										Description: "The flag to allow the packet through the filter",
-									},
-									"logging": {
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Description: "The flag to enable packet logging when matching the expression",
-									},
-									"description": {
-										Type:             schema.TypeString,
-										Optional:         true,
-										ValidateDiagFunc: isValidLengthBetween(0, 512),
-										Description:      desc.Sprintf("The description of the expression. %s", desc.Length(0, 512)),
-									},
-								},
-							},
-						},
-					},
-				},
+				Computed: true,
+				Elem:     schemaResourceVPCRouterFirewall(),
 			},
 			"l2tp": {
 				Type:     schema.TypeList,
@@ -338,43 +261,8 @@ func resourceSakuraCloudVPCRouter() *schema.Resource {
 			"port_forwarding": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"protocol": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"tcp", "udp"}, false)),
-							Description: desc.Sprintf(
-								"The protocol used for port forwarding. This must be one of [%s]",
-								[]string{"tcp", "udp"},
-							),
-						},
-						"public_port": {
-							Type:             schema.TypeInt,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
-							Description:      "The source port number of the port forwarding. This must be a port number on a public network",
-						},
-						"private_ip": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validateIPv4Address(),
-							Description:      "The destination ip address of the port forwarding",
-						},
-						"private_port": {
-							Type:             schema.TypeInt,
-							Required:         true,
-							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
-							Description:      "The destination port number of the port forwarding. This will be a port number on a private network",
-						},
-						"description": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: isValidLengthBetween(0, 512),
-							Description:      desc.Sprintf("The description of the port forwarding. %s", desc.Length(0, 512)),
-						},
-					},
-				},
+				Computed: true,
+				Elem:     schemaResourceVPCRouterPortForwarding(),
 			},
 			"pptp": {
 				Type:     schema.TypeList,
@@ -430,8 +318,27 @@ func resourceSakuraCloudVPCRouter() *schema.Resource {
 									},
 									"public_key": {
 										Type:        schema.TypeString,
-										Required:    true,
-										Description: "the public key of the WireGuard client",
+										Optional:    true,
+										Computed:    true,
+										Description: "the public key of the WireGuard client. Either this or `generate_key` must be specified",
+									},
+									"generate_key": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "The flag to generate the key pair of the WireGuard client on the local machine",
+									},
+									"private_key": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Sensitive:   true,
+										Description: "The private key of the WireGuard client. This will be set only when `generate_key` is true",
+									},
+									"client_config": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Sensitive:   true,
+										Description: "The configuration for the WireGuard client in the wg-quick format",
 									},
 								},
 							},
@@ -574,47 +481,14 @@ func resourceSakuraCloudVPCRouter() *schema.Resource {
 			"static_nat": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"public_ip": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validateIPv4Address(),
-							Description:      "The public IP address used for the static NAT",
-						},
-						"private_ip": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validateIPv4Address(),
-							Description:      "The private IP address used for the static NAT",
-						},
-						"description": {
-							Type:             schema.TypeString,
-							Optional:         true,
-							ValidateDiagFunc: isValidLengthBetween(0, 512),
-							Description:      desc.Sprintf("The description of the static nat. %s", desc.Length(0, 512)),
-						},
-					},
-				},
+				Computed: true,
+				Elem:     schemaResourceVPCRouterStaticNAT(),
 			},
 			"static_route": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"prefix": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The CIDR block of destination",
-						},
-						"next_hop": {
-							Type:             schema.TypeString,
-							Required:         true,
-							ValidateDiagFunc: validateIPv4Address(),
-							Description:      "The IP address of the next hop",
-						},
-					},
-				},
+				Computed: true,
+				Elem:     schemaResourceVPCRouterStaticRoute(),
 			},
 			"scheduled_maintenance": {
 				Type:     schema.TypeList,
@@ -698,12 +572,194 @@ func resourceSakuraCloudVPCRouter() *schema.Resource {
 	}
 }
 
+func schemaResourceVPCRouterDHCPStaticMapping() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ip_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The static IP address to assign to DHCP client",
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The source MAC address of static mapping",
+			},
+		},
+	}
+}
+
+func schemaResourceVPCRouterFirewall() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"interface_index": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 7)),
+				Description: desc.Sprintf(
+					"The index of the network interface on which to enable filtering. %s",
+					desc.Range(0, 7),
+				),
+			},
+			"direction": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"send", "receive"}, false)),
+				Description: desc.Sprintf(
+					"The direction to apply the firewall. This must be one of [%s]",
+					[]string{"send", "receive"},
+				),
+			},
+			"expression": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types.VPCRouterFirewallProtocolStrings, false)),
+							Description: desc.Sprintf(
+								"The protocol used for filtering. This must be one of [%s]",
+								types.VPCRouterFirewallProtocolStrings,
+							),
+						},
+						"source_network": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A source IP address or CIDR block used for filtering (e.g. `192.0.2.1`, `192.0.2.0/24`)",
+						},
+						"source_port": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A source port number or port range used for filtering (e.g. `1024`, `1024-2048`). This is only used when `protocol` is `tcp` or `udp`",
+						},
+						"destination_network": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A destination IP address or CIDR block used for filtering (e.g. `192.0.2.1`, `192.0.2.0/24`)",
+						},
+						"destination_port": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A destination port number or port range used for filtering (e.g. `1024`, `1024-2048`). This is only used when `protocol` is `tcp` or `udp`",
+						},
+						"allow": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "The flag to allow the packet through the filter",
+						},
+						"logging": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "The flag to enable packet logging when matching the expression",
+						},
+						"description": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: isValidLengthBetween(0, 512),
+							Description:      desc.Sprintf("The description of the expression. %s", desc.Length(0, 512)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaResourceVPCRouterPortForwarding() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"tcp", "udp"}, false)),
+				Description: desc.Sprintf(
+					"The protocol used for port forwarding. This must be one of [%s]",
+					[]string{"tcp", "udp"},
+				),
+			},
+			"public_port": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
+				Description:      "The source port number of the port forwarding. This must be a port number on a public network",
+			},
+			"private_ip": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIPv4Address(),
+				Description:      "The destination ip address of the port forwarding",
+			},
+			"private_port": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
+				Description:      "The destination port number of the port forwarding. This will be a port number on a private network",
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isValidLengthBetween(0, 512),
+				Description:      desc.Sprintf("The description of the port forwarding. %s", desc.Length(0, 512)),
+			},
+		},
+	}
+}
+
+func schemaResourceVPCRouterStaticNAT() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"public_ip": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIPv4Address(),
+				Description:      "The public IP address used for the static NAT",
+			},
+			"private_ip": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIPv4Address(),
+				Description:      "The private IP address used for the static NAT",
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isValidLengthBetween(0, 512),
+				Description:      desc.Sprintf("The description of the static nat. %s", desc.Length(0, 512)),
+			},
+		},
+	}
+}
+
+func schemaResourceVPCRouterStaticRoute() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CIDR block of destination",
+			},
+			"next_hop": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIPv4Address(),
+				Description:      "The IP address of the next hop",
+			},
+		},
+	}
+}
+
 func resourceSakuraCloudVPCRouterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, zone, err := sakuraCloudClient(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := generateVPCRouterWireGuardPeerKeys(d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	builder := expandVPCRouterBuilder(d, client, zone)
 	if err := builder.Validate(ctx, zone); err != nil {
 		return diag.Errorf("validating parameter for SakuraCloud VPCRouter is failed: %s", err)
@@ -740,7 +796,16 @@ func resourceSakuraCloudVPCRouterRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("could not read SakuraCloud VPCRouter[%s]: %s", d.Id(), err)
 	}
 
-	return setVPCRouterResourceData(ctx, d, zone, client, vpcRouter)
+	// Note: 生成した秘密鍵はAPIから参照できないため、stateに保持している値を引き継ぐ
+	peerKeys := expandVPCRouterWireGuardPeerKeys(d)
+	if diags := setVPCRouterResourceData(ctx, d, zone, client, vpcRouter); diags.HasError() {
+		return diags
+	}
+	wireGuard := flattenVPCRouterWireGuardPeerConfigs(vpcRouter, d.Get("wire_guard").([]interface{}), peerKeys)
+	if err := d.Set("wire_guard", wireGuard); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
 }
 
 func resourceSakuraCloudVPCRouterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -759,7 +824,13 @@ func resourceSakuraCloudVPCRouterUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("could not read SakuraCloud VPCRouter[%s]: %s", d.Id(), err)
 	}
 
+	if err := generateVPCRouterWireGuardPeerKeys(d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	builder := expandVPCRouterBuilder(d, client, zone)
+	// 設定に記載されていないリストはサブリソースで管理されている場合があるため、stateではなく現在の値を引き継ぐ
+	mergeVPCRouterSettingsNotInConfig(builder.RouterSetting, d.GetRawConfig(), vpcRouter.Settings)
 	if err := builder.Validate(ctx, zone); err != nil {
 		return diag.Errorf("validating parameter for SakuraCloud VPCRouter is failed: %s", err)
 	}
@@ -889,3 +960,61 @@ func setVPCRouterResourceData(ctx context.Context, d *schema.ResourceData, zone
 	d.Set("zone", getZone(d, client)) //nolint
 	return nil
 }
+
+// readVPCRouterSubResource sakuracloud_vpc_router_xxxのように設定の一部のみを扱うリソースのRead処理
+func readVPCRouterSubResource(ctx context.Context, d *schema.ResourceData, meta interface{}, setter func(data *iaas.VPCRouter) error) diag.Diagnostics {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vrOp := iaas.NewVPCRouterOp(client)
+
+	vpcRouter, err := vrOp.Read(ctx, zone, sakuraCloudID(d.Id()))
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("could not read SakuraCloud VPCRouter[%s]: %s", d.Id(), err)
+	}
+
+	d.Set("vpc_router_id", vpcRouter.ID.String()) //nolint
+	d.Set("zone", getZone(d, client))             //nolint
+	return diag.FromErr(setter(vpcRouter))
+}
+
+// updateVPCRouterSettings VPCルータの現在の設定に対しupdaterで変更を加えて反映する
+//
+// sakuracloud_vpc_routerや他のサブリソースと同じVPCルータIDでロックを取得する
+func updateVPCRouterSettings(ctx context.Context, d *schema.ResourceData, meta interface{}, updater func(settings *iaas.VPCRouterSetting)) error {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	vrOp := iaas.NewVPCRouterOp(client)
+	vpcRouterID := d.Get("vpc_router_id").(string)
+
+	sakuraMutexKV.Lock(vpcRouterID)
+	defer sakuraMutexKV.Unlock(vpcRouterID)
+
+	vpcRouter, err := vrOp.Read(ctx, zone, sakuraCloudID(vpcRouterID))
+	if err != nil {
+		return err
+	}
+
+	settings := vpcRouter.Settings
+	if settings == nil {
+		settings = &iaas.VPCRouterSetting{}
+	}
+	updater(settings)
+
+	if _, err := vrOp.UpdateSettings(ctx, zone, vpcRouter.ID, &iaas.VPCRouterUpdateSettingsRequest{
+		Settings:     settings,
+		SettingsHash: vpcRouter.SettingsHash,
+	}); err != nil {
+		return err
+	}
+	return vrOp.Config(ctx, zone, vpcRouter.ID)
+}
@@ -0,0 +1,121 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func TestAccSakuraCloudVPCRouterDHCPStaticMapping_basic(t *testing.T) {
+	resourceName := "sakuracloud_vpc_router_dhcp_static_mapping.foobar"
+	rand := randomName()
+
+	var vpcRouter iaas.VPCRouter
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudVPCRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudVPCRouterDHCPStaticMapping_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudVPCRouterExists("sakuracloud_vpc_router.foobar", &vpcRouter),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "vpc_router_id",
+						"sakuracloud_vpc_router.foobar", "id",
+					),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_static_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_static_mapping.0.ip_address", "192.168.11.10"),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_static_mapping.0.mac_address", "aa:bb:cc:aa:bb:cc"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudVPCRouterDHCPStaticMapping_update, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dhcp_static_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_static_mapping.0.ip_address", "192.168.11.9"),
+					resource.TestCheckResourceAttr(resourceName, "dhcp_static_mapping.0.mac_address", "aa:bb:cc:aa:bb:cd"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudVPCRouterDHCPStaticMapping_basic = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+
+  private_network_interface {
+    index        = 1
+    switch_id    = sakuracloud_switch.foobar.id
+    ip_addresses = ["192.168.11.1"]
+    netmask      = 24
+  }
+
+  dhcp_server {
+    interface_index = 1
+    range_start     = "192.168.11.11"
+    range_stop      = "192.168.11.20"
+  }
+}
+
+resource "sakuracloud_vpc_router_dhcp_static_mapping" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+
+  dhcp_static_mapping {
+    ip_address  = "192.168.11.10"
+    mac_address = "aa:bb:cc:aa:bb:cc"
+  }
+}
+`
+
+var testAccSakuraCloudVPCRouterDHCPStaticMapping_update = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+
+  private_network_interface {
+    index        = 1
+    switch_id    = sakuracloud_switch.foobar.id
+    ip_addresses = ["192.168.11.1"]
+    netmask      = 24
+  }
+
+  dhcp_server {
+    interface_index = 1
+    range_start     = "192.168.11.11"
+    range_stop      = "192.168.11.20"
+  }
+}
+
+resource "sakuracloud_vpc_router_dhcp_static_mapping" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+
+  dhcp_static_mapping {
+    ip_address  = "192.168.11.9"
+    mac_address = "aa:bb:cc:aa:bb:cd"
+  }
+}
+`
@@ -0,0 +1,129 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func TestAccSakuraCloudVPCRouterFirewall_basic(t *testing.T) {
+	resourceName := "sakuracloud_vpc_router_firewall.foobar"
+	rand := randomName()
+
+	var vpcRouter iaas.VPCRouter
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudVPCRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudVPCRouterFirewall_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudVPCRouterExists("sakuracloud_vpc_router.foobar", &vpcRouter),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "vpc_router_id",
+						"sakuracloud_vpc_router.foobar", "id",
+					),
+					resource.TestCheckResourceAttr(resourceName, "firewall.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.interface_index", "0"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.direction", "receive"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.expression.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.expression.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.expression.0.destination_port", "22"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.expression.0.allow", "true"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.expression.1.protocol", "ip"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.expression.1.allow", "false"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudVPCRouterFirewall_update, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "firewall.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.expression.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.expression.0.destination_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.expression.1.destination_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "firewall.0.expression.2.logging", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudVPCRouterFirewall_basic = `
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router_firewall" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+
+  firewall {
+    interface_index = 0
+    direction       = "receive"
+
+    expression {
+      protocol         = "tcp"
+      destination_port = "22"
+      allow            = true
+    }
+
+    expression {
+      protocol = "ip"
+      allow    = false
+    }
+  }
+}
+`
+
+var testAccSakuraCloudVPCRouterFirewall_update = `
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router_firewall" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+
+  firewall {
+    interface_index = 0
+    direction       = "receive"
+
+    expression {
+      protocol         = "tcp"
+      destination_port = "80"
+      allow            = true
+    }
+
+    expression {
+      protocol         = "tcp"
+      destination_port = "443"
+      allow            = true
+    }
+
+    expression {
+      protocol = "ip"
+      allow    = false
+      logging  = true
+    }
+  }
+}
+`
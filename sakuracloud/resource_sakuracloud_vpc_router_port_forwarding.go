@@ -0,0 +1,94 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func resourceSakuraCloudVPCRouterPortForwarding() *schema.Resource {
+	resourceName := "VPCRouter Port Forwarding"
+	return &schema.Resource{
+		CreateContext: resourceSakuraCloudVPCRouterPortForwardingUpdate,
+		ReadContext:   resourceSakuraCloudVPCRouterPortForwardingRead,
+		UpdateContext: resourceSakuraCloudVPCRouterPortForwardingUpdate,
+		DeleteContext: resourceSakuraCloudVPCRouterPortForwardingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importZonalResourceStateContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_router_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+				Description:      "The id of the VPC Router that set port forwarding settings to",
+			},
+			"port_forwarding": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     schemaResourceVPCRouterPortForwarding(),
+			},
+			"zone": schemaResourceZone(resourceName),
+		},
+	}
+}
+
+func resourceSakuraCloudVPCRouterPortForwardingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readVPCRouterSubResource(ctx, d, meta, func(data *iaas.VPCRouter) error {
+		return d.Set("port_forwarding", flattenVPCRouterPortForwardings(data))
+	})
+}
+
+func resourceSakuraCloudVPCRouterPortForwardingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcRouterID := d.Get("vpc_router_id").(string)
+	err := updateVPCRouterSettings(ctx, d, meta, func(settings *iaas.VPCRouterSetting) {
+		settings.PortForwarding = expandVPCRouterPortForwardingList(d)
+	})
+	if err != nil {
+		return diag.Errorf("updating SakuraCloud VPCRouter[%s] is failed: %s", vpcRouterID, err)
+	}
+
+	d.SetId(vpcRouterID)
+	return resourceSakuraCloudVPCRouterPortForwardingRead(ctx, d, meta)
+}
+
+func resourceSakuraCloudVPCRouterPortForwardingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcRouterID := d.Get("vpc_router_id").(string)
+	err := updateVPCRouterSettings(ctx, d, meta, func(settings *iaas.VPCRouterSetting) {
+		settings.PortForwarding = nil
+	})
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("updating SakuraCloud VPCRouter[%s] is failed: %s", vpcRouterID, err)
+	}
+	return nil
+}
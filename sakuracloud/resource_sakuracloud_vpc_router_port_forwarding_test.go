@@ -0,0 +1,126 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func TestAccSakuraCloudVPCRouterPortForwarding_basic(t *testing.T) {
+	resourceName := "sakuracloud_vpc_router_port_forwarding.foobar"
+	rand := randomName()
+
+	var vpcRouter iaas.VPCRouter
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudVPCRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudVPCRouterPortForwarding_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudVPCRouterExists("sakuracloud_vpc_router.foobar", &vpcRouter),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "vpc_router_id",
+						"sakuracloud_vpc_router.foobar", "id",
+					),
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.0.public_port", "10022"),
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.0.private_ip", "192.168.11.11"),
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.0.private_port", "22"),
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.0.description", "desc"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudVPCRouterPortForwarding_update, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.0.public_port", "10080"),
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.0.private_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.1.protocol", "udp"),
+					resource.TestCheckResourceAttr(resourceName, "port_forwarding.1.public_port", "10053"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudVPCRouterPortForwarding_basic = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+
+  private_network_interface {
+    index        = 1
+    switch_id    = sakuracloud_switch.foobar.id
+    ip_addresses = ["192.168.11.1"]
+    netmask      = 24
+  }
+}
+
+resource "sakuracloud_vpc_router_port_forwarding" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+
+  port_forwarding {
+    protocol     = "tcp"
+    public_port  = 10022
+    private_ip   = "192.168.11.11"
+    private_port = 22
+    description  = "desc"
+  }
+}
+`
+
+var testAccSakuraCloudVPCRouterPortForwarding_update = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+
+  private_network_interface {
+    index        = 1
+    switch_id    = sakuracloud_switch.foobar.id
+    ip_addresses = ["192.168.11.1"]
+    netmask      = 24
+  }
+}
+
+resource "sakuracloud_vpc_router_port_forwarding" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+
+  port_forwarding {
+    protocol     = "tcp"
+    public_port  = 10080
+    private_ip   = "192.168.11.11"
+    private_port = 80
+  }
+
+  port_forwarding {
+    protocol     = "udp"
+    public_port  = 10053
+    private_ip   = "192.168.11.12"
+    private_port = 53
+  }
+}
+`
@@ -0,0 +1,122 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func TestAccSakuraCloudVPCRouterStaticNAT_basic(t *testing.T) {
+	resourceName := "sakuracloud_vpc_router_static_nat.foobar"
+	rand := randomName()
+
+	var vpcRouter iaas.VPCRouter
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudVPCRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudVPCRouterStaticNAT_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudVPCRouterExists("sakuracloud_vpc_router.foobar", &vpcRouter),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "vpc_router_id",
+						"sakuracloud_vpc_router.foobar", "id",
+					),
+					resource.TestCheckResourceAttr(resourceName, "static_nat.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "static_nat.0.private_ip", "192.168.11.12"),
+					resource.TestCheckResourceAttr(resourceName, "static_nat.0.description", "desc"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "static_nat.0.public_ip",
+						"sakuracloud_internet.foobar", "ip_addresses.3",
+					),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudVPCRouterStaticNAT_update, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "static_nat.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "static_nat.0.private_ip", "192.168.11.13"),
+					resource.TestCheckResourceAttr(resourceName, "static_nat.0.description", ""),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "static_nat.0.public_ip",
+						"sakuracloud_internet.foobar", "ip_addresses.4",
+					),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudVPCRouterStaticNAT_basic = `
+resource "sakuracloud_internet" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+  plan = "premium"
+
+  public_network_interface {
+    switch_id    = sakuracloud_internet.foobar.switch_id
+    vip          = sakuracloud_internet.foobar.ip_addresses[0]
+    ip_addresses = [sakuracloud_internet.foobar.ip_addresses[1], sakuracloud_internet.foobar.ip_addresses[2]]
+    aliases      = [sakuracloud_internet.foobar.ip_addresses[3], sakuracloud_internet.foobar.ip_addresses[4]]
+    vrid         = 1
+  }
+}
+
+resource "sakuracloud_vpc_router_static_nat" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+
+  static_nat {
+    public_ip   = sakuracloud_internet.foobar.ip_addresses[3]
+    private_ip  = "192.168.11.12"
+    description = "desc"
+  }
+}
+`
+
+var testAccSakuraCloudVPCRouterStaticNAT_update = `
+resource "sakuracloud_internet" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+  plan = "premium"
+
+  public_network_interface {
+    switch_id    = sakuracloud_internet.foobar.switch_id
+    vip          = sakuracloud_internet.foobar.ip_addresses[0]
+    ip_addresses = [sakuracloud_internet.foobar.ip_addresses[1], sakuracloud_internet.foobar.ip_addresses[2]]
+    aliases      = [sakuracloud_internet.foobar.ip_addresses[3], sakuracloud_internet.foobar.ip_addresses[4]]
+    vrid         = 1
+  }
+}
+
+resource "sakuracloud_vpc_router_static_nat" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+
+  static_nat {
+    public_ip  = sakuracloud_internet.foobar.ip_addresses[4]
+    private_ip = "192.168.11.13"
+  }
+}
+`
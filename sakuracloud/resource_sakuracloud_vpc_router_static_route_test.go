@@ -0,0 +1,115 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/sacloud/iaas-api-go"
+)
+
+func TestAccSakuraCloudVPCRouterStaticRoute_basic(t *testing.T) {
+	resourceName := "sakuracloud_vpc_router_static_route.foobar"
+	rand := randomName()
+
+	var vpcRouter iaas.VPCRouter
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testCheckSakuraCloudVPCRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudVPCRouterStaticRoute_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraCloudVPCRouterExists("sakuracloud_vpc_router.foobar", &vpcRouter),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "vpc_router_id",
+						"sakuracloud_vpc_router.foobar", "id",
+					),
+					resource.TestCheckResourceAttr(resourceName, "static_route.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "static_route.0.prefix", "172.16.16.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "static_route.0.next_hop", "192.168.11.99"),
+				),
+			},
+			{
+				Config: buildConfigWithArgs(testAccSakuraCloudVPCRouterStaticRoute_update, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "static_route.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "static_route.0.prefix", "172.16.16.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "static_route.1.prefix", "172.16.17.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "static_route.1.next_hop", "192.168.11.98"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraCloudVPCRouterStaticRoute_basic = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+
+  private_network_interface {
+    index        = 1
+    switch_id    = sakuracloud_switch.foobar.id
+    ip_addresses = ["192.168.11.1"]
+    netmask      = 24
+  }
+}
+
+resource "sakuracloud_vpc_router_static_route" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+
+  static_route {
+    prefix   = "172.16.16.0/16"
+    next_hop = "192.168.11.99"
+  }
+}
+`
+
+var testAccSakuraCloudVPCRouterStaticRoute_update = `
+resource "sakuracloud_switch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakuracloud_vpc_router" "foobar" {
+  name = "{{ .arg0 }}"
+
+  private_network_interface {
+    index        = 1
+    switch_id    = sakuracloud_switch.foobar.id
+    ip_addresses = ["192.168.11.1"]
+    netmask      = 24
+  }
+}
+
+resource "sakuracloud_vpc_router_static_route" "foobar" {
+  vpc_router_id = sakuracloud_vpc_router.foobar.id
+
+  static_route {
+    prefix   = "172.16.16.0/16"
+    next_hop = "192.168.11.99"
+  }
+
+  static_route {
+    prefix   = "172.16.17.0/16"
+    next_hop = "192.168.11.98"
+  }
+}
+`
@@ -30,6 +30,9 @@ func resourceSakuraCloudWebAccelCertificate() *schema.Resource {
 		ReadContext:   resourceSakuraCloudWebAccelCertificateRead,
 		UpdateContext: resourceSakuraCloudWebAccelCertificateUpdate,
 		DeleteContext: resourceSakuraCloudWebAccelCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"site_id": {
 				Type:     schema.TypeString,
@@ -260,3 +260,30 @@ func schemaDataSourcePort() *schema.Schema {
 		Description: "The number of the listening port",
 	}
 }
+
+func schemaResourceWaitForHealthy(resourceName string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timeout_sec": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          300,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+					Description:      "The timeout in seconds to wait for the servers to become healthy",
+				},
+				"min_healthy_count": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          1,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+					Description:      "The minimum number of healthy servers",
+				},
+			},
+		},
+		Description: desc.Sprintf("The settings to wait for the servers of the %s to become healthy after creating or updating", resourceName),
+	}
+}
@@ -15,6 +15,10 @@
 package sakuracloud
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -263,3 +267,81 @@ func flattenCertificateAuthorityServersForData(servers []*iaas.CertificateAuthor
 
 	return results
 }
+
+// flattenCertificateAuthorityClientsForImport インポート時にAPIから取得した全てのクライアント証明書をclientブロックとして組み立てる
+func flattenCertificateAuthorityClientsForImport(clients []*iaas.CertificateAuthorityClient) []interface{} {
+	var results []interface{}
+	for _, client := range clients {
+		results = append(results, map[string]interface{}{
+			"id":                    client.ID,
+			"subject":               flattenCertificateAuthorityCertSubject(client.Subject, client.CertificateData),
+			"validity_period_hours": flattenCertificateAuthorityValidityPeriodHours(client.CertificateData),
+			"email":                 client.EMail,
+		})
+	}
+	return results
+}
+
+// flattenCertificateAuthorityServersForImport インポート時にAPIから取得した全てのサーバ証明書をserverブロックとして組み立てる
+func flattenCertificateAuthorityServersForImport(servers []*iaas.CertificateAuthorityServer) []interface{} {
+	var results []interface{}
+	for _, server := range servers {
+		results = append(results, map[string]interface{}{
+			"id":                    server.ID,
+			"subject":               flattenCertificateAuthorityCertSubject(server.Subject, server.CertificateData),
+			"validity_period_hours": flattenCertificateAuthorityValidityPeriodHours(server.CertificateData),
+		})
+	}
+	return results
+}
+
+func flattenCertificateAuthorityValidityPeriodHours(data *iaas.CertificateData) int {
+	if data == nil {
+		return 0
+	}
+	return int(math.Round(data.NotAfter.Sub(data.NotBefore).Hours()))
+}
+
+// flattenCertificateAuthorityCertSubject 証明書のサブジェクトをsubjectブロックとして組み立てる
+//
+// 発行済みの場合は証明書から、未発行の場合は"CN=example.com,O=example,C=JP"形式の文字列から組み立てる。
+func flattenCertificateAuthorityCertSubject(subject string, data *iaas.CertificateData) []interface{} {
+	if data != nil {
+		if block, _ := pem.Decode([]byte(data.CertificatePEM)); block != nil {
+			if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+				return []interface{}{
+					map[string]interface{}{
+						"common_name":        cert.Subject.CommonName,
+						"country":            strings.Join(cert.Subject.Country, ","),
+						"organization":       strings.Join(cert.Subject.Organization, ","),
+						"organization_units": cert.Subject.OrganizationalUnit,
+					},
+				}
+			}
+		}
+	}
+
+	v := map[string]interface{}{
+		"common_name":        "",
+		"country":            "",
+		"organization":       "",
+		"organization_units": []string{},
+	}
+	for _, part := range strings.FieldsFunc(subject, func(r rune) bool { return r == ',' || r == '/' }) {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.ToUpper(kv[0]) {
+		case "CN":
+			v["common_name"] = kv[1]
+		case "C":
+			v["country"] = kv[1]
+		case "O":
+			v["organization"] = kv[1]
+		case "OU":
+			v["organization_units"] = append(v["organization_units"].([]string), kv[1])
+		}
+	}
+	return []interface{}{v}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/sacloud/iaas-api-go"
@@ -137,6 +138,43 @@ func expandDatabaseReadReplicaBuilder(ctx context.Context, d *schema.ResourceDat
 	}, nil
 }
 
+// expandPromotedDatabaseReadReplicaBuilder マスターへ昇格させる/昇格済みのリードレプリカを更新するためのBuilderを組み立てる
+//
+// 元のマスターが停止/削除されていても更新できるように、マスターの情報は参照せず現在の設定を元にする
+func expandPromotedDatabaseReadReplicaBuilder(d *schema.ResourceData, client *APIClient, zone string, db *iaas.Database) *databaseBuilder.Builder {
+	replicaUser := ""
+	if db.ReplicationSetting != nil {
+		replicaUser = db.ReplicationSetting.User
+	}
+	nic := expandDatabaseNetworkInterface(d)
+
+	return &databaseBuilder.Builder{
+		Zone:           zone,
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Tags:           expandTags(d),
+		IconID:         expandSakuraCloudID(d, "icon_id"),
+		PlanID:         db.PlanID,
+		SwitchID:       db.SwitchID,
+		IPAddresses:    db.IPAddresses,
+		NetworkMaskLen: db.NetworkMaskLen,
+		DefaultRoute:   db.DefaultRoute,
+		Conf:           db.Conf,
+		CommonSetting: &iaas.DatabaseSettingCommon{
+			ServicePort:   db.CommonSetting.ServicePort,
+			SourceNetwork: nic.sourceRanges,
+		},
+		// 昇格後も同じレプリケーション用ユーザーで新たなリードレプリカを作成できるようにする
+		ReplicationSetting: &iaas.DatabaseReplicationSetting{
+			Model:    types.DatabaseReplicationModels.MasterSlave,
+			User:     replicaUser,
+			Password: d.Get("replica_password").(string),
+		},
+		Disk:   expandDatabaseDisk(d),
+		Client: databaseBuilder.NewAPIClient(client),
+	}
+}
+
 func flattenDatabaseType(db *iaas.Database) string {
 	return strings.ToLower(db.Conf.DatabaseName)
 }
@@ -301,3 +339,97 @@ func flattenDatabaseDisk(data *iaas.Database) []any {
 		},
 	}
 }
+
+func expandDatabaseRestoreBackupID(d resourceValueGettable) string {
+	d = mapFromFirstElement(d, "restore_from")
+	if d == nil {
+		return ""
+	}
+	return d.Get("backup_id").(string)
+}
+
+func expandDatabaseMaintenanceWindow(d resourceValueGettable) (*databaseMaintenanceWindow, error) {
+	d = mapFromFirstElement(d, "maintenance_window")
+	if d == nil {
+		return nil, nil
+	}
+	location, err := time.LoadLocation(d.Get("time_zone").(string))
+	if err != nil {
+		return nil, fmt.Errorf("maintenance_window: %s", err)
+	}
+	startTime, err := time.Parse("15:04", d.Get("start_time").(string))
+	if err != nil {
+		return nil, fmt.Errorf("maintenance_window: %s", err)
+	}
+
+	window := &databaseMaintenanceWindow{
+		startTime: time.Duration(startTime.Hour())*time.Hour + time.Duration(startTime.Minute())*time.Minute,
+		duration:  time.Duration(d.Get("duration_hours").(int)) * time.Hour,
+		location:  location,
+		force:     d.Get("force").(bool),
+	}
+	for _, day := range expandBackupWeekdays(d, "days_of_week") {
+		window.daysOfWeek = append(window.daysOfWeek, time.Weekday(day.Int()))
+	}
+	return window, nil
+}
+
+// databaseRestartRequiredChanges 変更された項目のうちデータベースの再起動を伴う、または再起動後に反映されるものを返す
+func databaseRestartRequiredChanges(d *schema.ResourceData) []string {
+	var changes []string
+	if d.HasChange("replica_password") {
+		changes = append(changes, "replica_password")
+	}
+	// restart_required_parametersはparametersの変更時にCustomizeDiffで算出される
+	if d.HasChange("parameters") {
+		for _, key := range d.Get("restart_required_parameters").([]interface{}) {
+			changes = append(changes, "parameters."+key.(string))
+		}
+	}
+	if d.HasChange("restore_from") && expandDatabaseRestoreBackupID(d) != "" {
+		changes = append(changes, "restore_from")
+	}
+	return changes
+}
+
+// databaseReplacementKeys 変更されるとデータベースが再作成される項目
+var databaseReplacementKeys = []string{
+	"database_type",
+	"database_version",
+	"plan",
+	"username",
+	"network_interface.0.switch_id",
+	"network_interface.0.ip_address",
+	"network_interface.0.netmask",
+	"network_interface.0.gateway",
+	"disk.0.encryption_algorithm",
+	"disk.0.kms_key_id",
+}
+
+// databaseReplacementChanges 変更された項目のうちデータベースの再作成を伴うものを返す
+func databaseReplacementChanges(d *schema.ResourceDiff) []string {
+	var changes []string
+	for _, key := range databaseReplacementKeys {
+		if d.HasChange(key) {
+			changes = append(changes, key)
+		}
+	}
+	return changes
+}
+
+// restoreDatabase データベースのデータを自身のバックアップから復元し、起動を待つ
+func restoreDatabase(ctx context.Context, client *APIClient, zone string, id types.ID, backupID string) error {
+	dbOp := iaas.NewDatabaseOp(client)
+	status, err := dbOp.Status(ctx, zone, id)
+	if err != nil {
+		return fmt.Errorf("could not read status of SakuraCloud Database[%s]: %s", id, err)
+	}
+	if findDatabaseBackupHistory(status.Backups, backupID) == nil {
+		return fmt.Errorf("restore_from: backup %s is not found in Database[%s]", backupID, id)
+	}
+
+	if err := newDatabaseBackupOp(client).Restore(ctx, zone, id, backupID); err != nil {
+		return err
+	}
+	return waitForDatabaseUp(ctx, dbOp, zone, id)
+}
@@ -0,0 +1,120 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakuracloud/internal/desc"
+)
+
+// schemaResourceDatabaseSQLConnection データベースアプライアンスへSQLで接続するリソース向けの共通スキーマを追加する
+func schemaResourceDatabaseSQLConnection(resourceName string, s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["database_id"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validateSakuracloudIDType),
+		Description:      desc.Sprintf("The id of the database appliance that the %s belongs to", resourceName),
+	}
+	s["host"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.NoZeroValues),
+		Description:      "The hostname or IP address used to connect to the database. If omitted, the IP address of the database appliance is used",
+	}
+	s["port"] = &schema.Schema{
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 65535)),
+		Description:      "The port number used to connect to the database. If omitted, the port number of the database appliance is used",
+	}
+	s["admin_username"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the user used to connect to the database. If omitted, the default user of the database appliance is used",
+	}
+	s["admin_password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The password of the user used to connect to the database",
+	}
+	s["zone"] = schemaResourceZone(resourceName)
+	return s
+}
+
+// expandDatabaseSQLConnInfo データベースアプライアンスの情報を元にSQL接続情報を組み立てる
+//
+// アプライアンスの参照に失敗した場合はAPIのエラーをそのまま返す
+func expandDatabaseSQLConnInfo(ctx context.Context, d *schema.ResourceData, meta interface{}) (*databaseSQLConnInfo, error) {
+	client, zone, err := sakuraCloudClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := iaas.NewDatabaseOp(client).Read(ctx, zone, expandSakuraCloudID(d, "database_id"))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &databaseSQLConnInfo{
+		databaseType: flattenDatabaseType(db),
+		port:         db.CommonSetting.ServicePort,
+		user:         db.CommonSetting.DefaultUser,
+		password:     d.Get("admin_password").(string),
+	}
+	if len(db.IPAddresses) > 0 {
+		info.host = db.IPAddresses[0]
+	}
+	if v, ok := d.GetOk("host"); ok {
+		info.host = v.(string)
+	}
+	if v, ok := d.GetOk("port"); ok {
+		info.port = v.(int)
+	}
+	if v, ok := d.GetOk("admin_username"); ok {
+		info.user = v.(string)
+	}
+	if info.host == "" {
+		return nil, fmt.Errorf("database[%s] does not have an IP address", db.ID)
+	}
+	return info, nil
+}
+
+// withDatabaseSQLClient データベースアプライアンスへ接続しfを実行する
+//
+// 同一アプライアンスに対するDDLの同時実行を避けるためdatabase_idでロックする
+func withDatabaseSQLClient(ctx context.Context, d *schema.ResourceData, meta interface{}, f func(client databaseSQLClient, info *databaseSQLConnInfo) error) error {
+	databaseID := d.Get("database_id").(string)
+	sakuraMutexKV.Lock(databaseID)
+	defer sakuraMutexKV.Unlock(databaseID)
+
+	info, err := expandDatabaseSQLConnInfo(ctx, d, meta)
+	if err != nil {
+		return err
+	}
+	client, err := openDatabaseSQLClient(ctx, info)
+	if err != nil {
+		return err
+	}
+	defer client.Close() //nolint:errcheck
+
+	return f(client, info)
+}
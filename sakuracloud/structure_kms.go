@@ -16,6 +16,8 @@ package sakuracloud
 
 import (
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	kms "github.com/sacloud/kms-api-go/apis/v1"
@@ -54,6 +56,7 @@ func expandKMSUpdateKey(d *schema.ResourceData, before *kms.Key) kms.Key {
 	req := kms.Key{
 		Name:      d.Get("name").(string),
 		KeyOrigin: kms.KeyOriginEnum(d.Get("key_origin").(string)),
+		Status:    before.Status,
 	}
 
 	if _, ok := d.GetOk("tags"); ok {
@@ -65,3 +68,36 @@ func expandKMSUpdateKey(d *schema.ResourceData, before *kms.Key) kms.Key {
 
 	return req
 }
+
+func kmsKeyStatuses() []string {
+	var statuses []string
+	for _, s := range kms.ChangeKeyStatusStatusActive.AllValues() {
+		statuses = append(statuses, string(s))
+	}
+	return statuses
+}
+
+// isKMSKeyStatusChangeable statusで指定可能な状態か(pending_destructionなどの状態ではないか)
+func isKMSKeyStatusChangeable(status string) bool {
+	return slices.Contains(kmsKeyStatuses(), status)
+}
+
+func expandKMSKeyStatus(d resourceValueGettable) kms.ChangeKeyStatusStatus {
+	if v, ok := d.GetOk("status"); ok {
+		return kms.ChangeKeyStatusStatus(v.(string))
+	}
+	return ""
+}
+
+// isKMSRotationDue rotation.interval_daysで指定された期間がrotatedAtから経過しているか
+func isKMSRotationDue(d resourceValueGettable, rotatedAt string, now time.Time) bool {
+	intervalDays := intOrDefault(d, "rotation.0.interval_days")
+	if intervalDays <= 0 || rotatedAt == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return false
+	}
+	return !now.Before(t.AddDate(0, 0, intervalDays))
+}
@@ -0,0 +1,84 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsKMSRotationDue(t *testing.T) {
+	now := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name      string
+		values    map[string]interface{}
+		rotatedAt string
+		expect    bool
+	}{
+		{
+			name:      "rotation is not configured",
+			values:    map[string]interface{}{},
+			rotatedAt: "2025-01-01T00:00:00Z",
+			expect:    false,
+		},
+		{
+			name:      "rotated_at is empty",
+			values:    map[string]interface{}{"rotation.0.interval_days": 30},
+			rotatedAt: "",
+			expect:    false,
+		},
+		{
+			name:      "interval has not elapsed",
+			values:    map[string]interface{}{"rotation.0.interval_days": 30},
+			rotatedAt: "2025-06-01T00:00:01Z",
+			expect:    false,
+		},
+		{
+			name:      "interval has just elapsed",
+			values:    map[string]interface{}{"rotation.0.interval_days": 30},
+			rotatedAt: "2025-05-31T00:00:00Z",
+			expect:    true,
+		},
+		{
+			name:      "invalid rotated_at",
+			values:    map[string]interface{}{"rotation.0.interval_days": 30},
+			rotatedAt: "invalid",
+			expect:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := isKMSRotationDue(mapToResourceData(tc.values), tc.rotatedAt, now)
+			if got != tc.expect {
+				t.Errorf("expected %t, got %t", tc.expect, got)
+			}
+		})
+	}
+}
+
+func TestIsKMSKeyStatusChangeable(t *testing.T) {
+	for _, status := range []string{"active", "restricted", "suspended"} {
+		if !isKMSKeyStatusChangeable(status) {
+			t.Errorf("%s: expected changeable", status)
+		}
+	}
+	for _, status := range []string{"pending_destruction", ""} {
+		if isKMSKeyStatusChangeable(status) {
+			t.Errorf("%q: expected not changeable", status)
+		}
+	}
+}
@@ -15,6 +15,9 @@
 package sakuracloud
 
 import (
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/sacloud/iaas-api-go"
 	"github.com/sacloud/iaas-api-go/types"
@@ -146,39 +149,47 @@ func flattenProxyLBSorryServer(proxyLB *iaas.ProxyLB) []interface{} {
 func flattenProxyLBServers(proxyLB *iaas.ProxyLB) []interface{} {
 	var results []interface{}
 	for _, server := range proxyLB.Servers {
-		results = append(results, map[string]interface{}{
-			"ip_address": server.IPAddress,
-			"port":       server.Port,
-			"enabled":    server.Enabled,
-			"group":      server.ServerGroup,
-		})
+		results = append(results, flattenProxyLBServer(server))
 	}
 	return results
 }
 
+func flattenProxyLBServer(server *iaas.ProxyLBServer) map[string]interface{} {
+	return map[string]interface{}{
+		"ip_address": server.IPAddress,
+		"port":       server.Port,
+		"enabled":    server.Enabled,
+		"group":      server.ServerGroup,
+	}
+}
+
 func flattenProxyLBRules(proxyLB *iaas.ProxyLB) []interface{} {
 	var results []interface{}
 	for _, rule := range proxyLB.Rules {
-		results = append(results, map[string]interface{}{
-			"host":                             rule.Host,
-			"path":                             rule.Path,
-			"source_ips":                       rule.SourceIPs,
-			"request_header_name":              rule.RequestHeaderName,
-			"request_header_value":             rule.RequestHeaderValue,
-			"request_header_value_ignore_case": rule.RequestHeaderValueIgnoreCase,
-			"request_header_value_not_match":   rule.RequestHeaderValueNotMatch,
-			"group":                            rule.ServerGroup,
-			"action":                           rule.Action.String(),
-			"redirect_location":                rule.RedirectLocation,
-			"redirect_status_code":             rule.RedirectStatusCode.String(),
-			"fixed_status_code":                rule.FixedStatusCode.String(),
-			"fixed_content_type":               rule.FixedContentType.String(),
-			"fixed_message_body":               rule.FixedMessageBody,
-		})
+		results = append(results, flattenProxyLBRule(rule))
 	}
 	return results
 }
 
+func flattenProxyLBRule(rule *iaas.ProxyLBRule) map[string]interface{} {
+	return map[string]interface{}{
+		"host":                             rule.Host,
+		"path":                             rule.Path,
+		"source_ips":                       rule.SourceIPs,
+		"request_header_name":              rule.RequestHeaderName,
+		"request_header_value":             rule.RequestHeaderValue,
+		"request_header_value_ignore_case": rule.RequestHeaderValueIgnoreCase,
+		"request_header_value_not_match":   rule.RequestHeaderValueNotMatch,
+		"group":                            rule.ServerGroup,
+		"action":                           rule.Action.String(),
+		"redirect_location":                rule.RedirectLocation,
+		"redirect_status_code":             rule.RedirectStatusCode.String(),
+		"fixed_status_code":                rule.FixedStatusCode.String(),
+		"fixed_content_type":               rule.FixedContentType.String(),
+		"fixed_message_body":               rule.FixedMessageBody,
+	}
+}
+
 func flattenProxyLBCerts(certs *iaas.ProxyLBCertificates) []interface{} {
 	if certs == nil {
 		return nil
@@ -373,47 +384,64 @@ func expandProxyLBServers(d resourceValueGettable) []*iaas.ProxyLBServer {
 	if servers, ok := getListFromResource(d, "server"); ok && len(servers) > 0 {
 		for _, server := range servers {
 			v := mapToResourceData(server.(map[string]interface{}))
-			results = append(results, &iaas.ProxyLBServer{
-				IPAddress:   v.Get("ip_address").(string),
-				Port:        v.Get("port").(int),
-				Enabled:     v.Get("enabled").(bool),
-				ServerGroup: v.Get("group").(string),
-			})
+			results = append(results, expandProxyLBServer(v))
 		}
 	}
 	return results
 }
 
+func expandProxyLBServer(d resourceValueGettable) *iaas.ProxyLBServer {
+	return &iaas.ProxyLBServer{
+		IPAddress:   d.Get("ip_address").(string),
+		Port:        d.Get("port").(int),
+		Enabled:     d.Get("enabled").(bool),
+		ServerGroup: d.Get("group").(string),
+	}
+}
+
 func expandProxyLBRules(d resourceValueGettable) []*iaas.ProxyLBRule {
 	var results []*iaas.ProxyLBRule
 	if rules, ok := getListFromResource(d, "rule"); ok && len(rules) > 0 {
 		for _, rule := range rules {
 			v := mapToResourceData(rule.(map[string]interface{}))
-			results = append(results, &iaas.ProxyLBRule{
-				Host:                         v.Get("host").(string),
-				Path:                         v.Get("path").(string),
-				SourceIPs:                    v.Get("source_ips").(string),
-				RequestHeaderName:            v.Get("request_header_name").(string),
-				RequestHeaderValue:           v.Get("request_header_value").(string),
-				RequestHeaderValueIgnoreCase: v.Get("request_header_value_ignore_case").(bool),
-				RequestHeaderValueNotMatch:   v.Get("request_header_value_not_match").(bool),
-				ServerGroup:                  v.Get("group").(string),
-				Action:                       types.EProxyLBRuleAction(v.Get("action").(string)),
-				RedirectLocation:             v.Get("redirect_location").(string),
-				RedirectStatusCode:           types.EProxyLBRedirectStatusCode(forceAtoI(v.Get("redirect_status_code").(string))),
-				FixedStatusCode:              types.EProxyLBFixedStatusCode(forceAtoI(v.Get("fixed_status_code").(string))),
-				FixedContentType:             types.EProxyLBFixedContentType(v.Get("fixed_content_type").(string)),
-				FixedMessageBody:             v.Get("fixed_message_body").(string),
-			})
+			results = append(results, expandProxyLBRule(v))
 		}
 	}
 	return results
 }
 
+func expandProxyLBRule(d resourceValueGettable) *iaas.ProxyLBRule {
+	return &iaas.ProxyLBRule{
+		Host:                         d.Get("host").(string),
+		Path:                         d.Get("path").(string),
+		SourceIPs:                    d.Get("source_ips").(string),
+		RequestHeaderName:            d.Get("request_header_name").(string),
+		RequestHeaderValue:           d.Get("request_header_value").(string),
+		RequestHeaderValueIgnoreCase: d.Get("request_header_value_ignore_case").(bool),
+		RequestHeaderValueNotMatch:   d.Get("request_header_value_not_match").(bool),
+		ServerGroup:                  d.Get("group").(string),
+		Action:                       types.EProxyLBRuleAction(d.Get("action").(string)),
+		RedirectLocation:             d.Get("redirect_location").(string),
+		RedirectStatusCode:           types.EProxyLBRedirectStatusCode(forceAtoI(d.Get("redirect_status_code").(string))),
+		FixedStatusCode:              types.EProxyLBFixedStatusCode(forceAtoI(d.Get("fixed_status_code").(string))),
+		FixedContentType:             types.EProxyLBFixedContentType(d.Get("fixed_content_type").(string)),
+		FixedMessageBody:             d.Get("fixed_message_body").(string),
+	}
+}
+
 func expandProxyLBTimeout(d resourceValueGettable) *iaas.ProxyLBTimeout {
 	return &iaas.ProxyLBTimeout{InactiveSec: d.Get("timeout").(int)}
 }
 
+// isProxyLBAdditionalCertsInConfig certificate.additional_certificateが設定に記載されているかを返す
+func isProxyLBAdditionalCertsInConfig(config cty.Value) bool {
+	certs := ctyListElements(config, "certificate")
+	if len(certs) == 0 {
+		return false
+	}
+	return !ctyIsEmpty(certs[0], "additional_certificate")
+}
+
 func expandProxyLBCerts(d resourceValueGettable) *iaas.ProxyLBCertificates {
 	// set cert
 	if certs, ok := getListFromResource(d, "certificate"); ok && len(certs) > 0 {
@@ -467,3 +495,53 @@ func expandProxyLBACMESetting(d resourceValueGettable) *iaas.ProxyLBACMESetting
 	}
 	return nil
 }
+
+func flattenProxyLBServerStatuses(health *iaas.ProxyLBHealth) []interface{} {
+	var results []interface{}
+	for _, s := range health.Servers {
+		results = append(results, map[string]interface{}{
+			"ip_address":  s.IPAddress,
+			"port":        s.Port.Int(),
+			"status":      string(s.Status),
+			"active_conn": s.ActiveConn.Int(),
+			"cps":         s.CPS.Float64(),
+		})
+	}
+	return results
+}
+
+func flattenProxyLBPrimaryCertStatus(certs *iaas.ProxyLBCertificates) []interface{} {
+	if certs == nil || certs.PrimaryCert == nil || certs.PrimaryCert.ServerCertificate == "" {
+		return nil
+	}
+	cert := certs.PrimaryCert
+	return []interface{}{
+		flattenProxyLBCertStatus(cert.CertificateCommonName, cert.CertificateAltNames, cert.CertificateEndDate, time.Now()),
+	}
+}
+
+func flattenProxyLBAdditionalCertStatuses(certs *iaas.ProxyLBCertificates) []interface{} {
+	if certs == nil {
+		return nil
+	}
+	var results []interface{}
+	for _, cert := range certs.AdditionalCerts {
+		results = append(results, flattenProxyLBCertStatus(cert.CertificateCommonName, cert.CertificateAltNames, cert.CertificateEndDate, time.Now()))
+	}
+	return results
+}
+
+func flattenProxyLBCertStatus(commonName, altNames string, endDate, now time.Time) map[string]interface{} {
+	notAfter := ""
+	daysUntilExpiry := 0
+	if !endDate.IsZero() {
+		notAfter = endDate.Format(time.RFC3339)
+		daysUntilExpiry = int(endDate.Sub(now).Hours() / 24)
+	}
+	return map[string]interface{}{
+		"common_name":       commonName,
+		"subject_alt_names": altNames,
+		"not_after":         notAfter,
+		"days_until_expiry": daysUntilExpiry,
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenProxyLBCertStatus(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	v := flattenProxyLBCertStatus("www.example.com", "www.example.com,example.com", now.Add(30*24*time.Hour+time.Hour), now)
+	require.Equal(t, map[string]interface{}{
+		"common_name":       "www.example.com",
+		"subject_alt_names": "www.example.com,example.com",
+		"not_after":         "2025-01-31T01:00:00Z",
+		"days_until_expiry": 30,
+	}, v)
+
+	v = flattenProxyLBCertStatus("", "", time.Time{}, now)
+	require.Equal(t, "", v["not_after"])
+	require.Equal(t, 0, v["days_until_expiry"])
+}
+
+func TestIsProxyLBAdditionalCertsInConfig(t *testing.T) {
+	certificate := func(additional cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"certificate": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"server_cert":            cty.StringVal("cert"),
+					"additional_certificate": additional,
+				}),
+			}),
+		})
+	}
+	additionalCert := cty.ObjectVal(map[string]cty.Value{"server_cert": cty.StringVal("cert")})
+
+	require.False(t, isProxyLBAdditionalCertsInConfig(cty.ObjectVal(map[string]cty.Value{
+		"certificate": cty.ListValEmpty(cty.EmptyObject),
+	})))
+	require.False(t, isProxyLBAdditionalCertsInConfig(certificate(cty.ListValEmpty(additionalCert.Type()))))
+	require.True(t, isProxyLBAdditionalCertsInConfig(certificate(cty.ListVal([]cty.Value{additionalCert}))))
+}
@@ -15,6 +15,8 @@
 package sakuracloud
 
 import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sm "github.com/sacloud/secretmanager-api-go/apis/v1"
 )
@@ -49,11 +51,30 @@ func expandSecretManagerUpdateVault(d *schema.ResourceData, before *sm.Vault) sm
 	return req
 }
 
-func expandSecretManagerCreateSecret(d *schema.ResourceData) sm.CreateSecret {
+func expandSecretManagerCreateSecret(d *schema.ResourceData) (sm.CreateSecret, diag.Diagnostics) {
+	value, diags := expandSecretManagerSecretValue(d)
 	req := sm.CreateSecret{
 		Name:  d.Get("name").(string),
-		Value: d.Get("value").(string),
+		Value: value,
 	}
 
-	return req
+	return req, diags
+}
+
+// expandSecretManagerSecretValue valueまたはvalue_woからシークレットの値を取得する
+//
+// value_woはWriteOnlyのためstateには保持されず、applyの際の設定値(RawConfig)からのみ参照できる
+func expandSecretManagerSecretValue(d *schema.ResourceData) (string, diag.Diagnostics) {
+	if v, ok := d.GetOk("value"); ok {
+		return v.(string), nil
+	}
+
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath("value_wo"))
+	if diags.HasError() {
+		return "", diags
+	}
+	if !v.Type().Equals(cty.String) || v.IsNull() || !v.IsKnown() {
+		return "", nil
+	}
+	return v.AsString(), nil
 }
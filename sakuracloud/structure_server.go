@@ -52,11 +52,32 @@ func expandServerBuilder(ctx context.Context, zone string, d *schema.ResourceDat
 		DiskBuilders:    diskBuilders,
 		Client:          serverBuilder.NewBuildersAPIClient(client),
 		ForceShutdown:   d.Get("force_shutdown").(bool),
-		BootAfterCreate: true,
+		BootAfterCreate: expandServerPowerState(d) != serverPowerStateDown,
 		UserData:        expandServerUserData(ctx, d),
 	}, nil
 }
 
+const (
+	serverPowerStateUp   = "up"
+	serverPowerStateDown = "down"
+)
+
+var serverPowerStates = []string{serverPowerStateUp, serverPowerStateDown}
+
+func expandServerPowerState(d resourceValueGettable) string {
+	if v, ok := d.GetOk("power_state"); ok {
+		return v.(string)
+	}
+	return ""
+}
+
+func flattenServerPowerState(server *iaas.Server) string {
+	if server.InstanceStatus.IsDown() {
+		return serverPowerStateDown
+	}
+	return serverPowerStateUp
+}
+
 func expandServerUserData(_ context.Context, d *schema.ResourceData) string {
 	if d.HasChanges("user_data") {
 		return d.Get("user_data").(string)
@@ -344,3 +344,34 @@ func TestStructureServer_isDiskEditParameterChanged(t *testing.T) {
 		}
 	}
 }
+
+func TestStructureServer_expandServerPowerState(t *testing.T) {
+	cases := []struct {
+		msg    string
+		in     resourceValueGettable
+		expect string
+	}{
+		{
+			msg:    "not specified",
+			in:     &resourceMapValue{value: map[string]interface{}{}},
+			expect: "",
+		},
+		{
+			msg:    "up",
+			in:     &resourceMapValue{value: map[string]interface{}{"power_state": "up"}},
+			expect: serverPowerStateUp,
+		},
+		{
+			msg:    "down",
+			in:     &resourceMapValue{value: map[string]interface{}{"power_state": "down"}},
+			expect: serverPowerStateDown,
+		},
+	}
+
+	for _, tc := range cases {
+		got := expandServerPowerState(tc.in)
+		if got != tc.expect {
+			t.Fatalf("got unexpected state: pattern: %s expected: %s actual: %s", tc.msg, tc.expect, got)
+		}
+	}
+}
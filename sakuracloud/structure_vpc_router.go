@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/sacloud/iaas-api-go"
 	"github.com/sacloud/iaas-api-go/defaults"
 	"github.com/sacloud/iaas-api-go/types"
@@ -46,6 +47,28 @@ func expandVPCRouterBuilder(d resourceValueGettable, client *APIClient, zone str
 	}
 }
 
+// mergeVPCRouterSettingsNotInConfig configで未指定のリストをcurrentの値で置き換える
+func mergeVPCRouterSettingsNotInConfig(rs *builder.RouterSetting, config cty.Value, current *iaas.VPCRouterSetting) {
+	if current == nil {
+		return
+	}
+	if ctyIsEmpty(config, "dhcp_static_mapping") {
+		rs.DHCPStaticMapping = current.DHCPStaticMapping
+	}
+	if ctyIsEmpty(config, "firewall") {
+		rs.Firewall = current.Firewall
+	}
+	if ctyIsEmpty(config, "port_forwarding") {
+		rs.PortForwarding = current.PortForwarding
+	}
+	if ctyIsEmpty(config, "static_nat") {
+		rs.StaticNAT = current.StaticNAT
+	}
+	if ctyIsEmpty(config, "static_route") {
+		rs.StaticRoute = current.StaticRoute
+	}
+}
+
 func expandVPCRouterPlanID(d resourceValueGettable) types.ID {
 	return types.VPCRouterPlanIDMap[d.Get("plan").(string)]
 }
@@ -860,3 +883,89 @@ func flattenVPCRouterMonitoringSuite(data *iaas.VPCRouter) []any {
 		},
 	}
 }
+
+func flattenVPCRouterStatusMemoryFree(status *iaas.VPCRouterStatus) []interface{} {
+	var results []interface{}
+	for _, v := range status.PercentageOfMemoryFree {
+		results = append(results, v.Float64())
+	}
+	return results
+}
+
+func flattenVPCRouterStatusWireGuardPublicKey(status *iaas.VPCRouterStatus) string {
+	if status.WireGuard != nil {
+		return status.WireGuard.PublicKey
+	}
+	return ""
+}
+
+func flattenVPCRouterStatusSiteToSiteVPNPeers(status *iaas.VPCRouterStatus) []interface{} {
+	var results []interface{}
+	for _, v := range status.SiteToSiteIPsecVPNPeers {
+		results = append(results, map[string]interface{}{
+			"peer":   v.Peer,
+			"status": v.Status,
+		})
+	}
+	return results
+}
+
+func flattenVPCRouterStatusDHCPServerLeases(status *iaas.VPCRouterStatus) []interface{} {
+	var results []interface{}
+	for _, v := range status.DHCPServerLeases {
+		results = append(results, map[string]interface{}{
+			"ip_address":  v.IPAddress,
+			"mac_address": v.MACAddress,
+		})
+	}
+	return results
+}
+
+func flattenVPCRouterStatusL2TPIPsecServerSessions(status *iaas.VPCRouterStatus) []interface{} {
+	var results []interface{}
+	for _, v := range status.L2TPIPsecServerSessions {
+		results = append(results, map[string]interface{}{
+			"user":       v.User,
+			"ip_address": v.IPAddress,
+			"time_sec":   v.TimeSec,
+		})
+	}
+	return results
+}
+
+func flattenVPCRouterStatusPPTPServerSessions(status *iaas.VPCRouterStatus) []interface{} {
+	var results []interface{}
+	for _, v := range status.PPTPServerSessions {
+		results = append(results, map[string]interface{}{
+			"user":       v.User,
+			"ip_address": v.IPAddress,
+			"time_sec":   v.TimeSec,
+		})
+	}
+	return results
+}
+
+func flattenVPCRouterStatusSessionAnalysis(status *iaas.VPCRouterStatus) []interface{} {
+	if status.SessionAnalysis == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"source_and_destination": flattenVPCRouterStatisticsValues(status.SessionAnalysis.SourceAndDestination),
+			"destination_address":    flattenVPCRouterStatisticsValues(status.SessionAnalysis.DestinationAddress),
+			"destination_port":       flattenVPCRouterStatisticsValues(status.SessionAnalysis.DestinationPort),
+			"source_address":         flattenVPCRouterStatisticsValues(status.SessionAnalysis.SourceAddress),
+		},
+	}
+}
+
+func flattenVPCRouterStatisticsValues(values []*iaas.VPCRouterStatisticsValue) []interface{} {
+	var results []interface{}
+	for _, v := range values {
+		results = append(results, map[string]interface{}{
+			"name":  v.Name,
+			"count": v.Count,
+		})
+	}
+	return results
+}
@@ -17,9 +17,12 @@ package sakuracloud
 import (
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/sacloud/iaas-api-go"
 	"github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/iaas-service-go/vpcrouter/builder"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFlattenVPCRouterL2TP(t *testing.T) {
@@ -227,3 +230,31 @@ func TestFlattenVPCRouterSiteToSiteConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeVPCRouterSettingsNotInConfig(t *testing.T) {
+	current := &iaas.VPCRouterSetting{
+		Firewall:       []*iaas.VPCRouterFirewall{{Index: 1}},
+		PortForwarding: []*iaas.VPCRouterPortForwarding{{Protocol: types.VPCRouterPortForwardingProtocols.TCP, GlobalPort: 8080, PrivateAddress: "192.168.11.11", PrivatePort: 80}},
+		StaticRoute:    []*iaas.VPCRouterStaticRoute{{Prefix: "172.16.0.0/16", NextHop: "192.168.11.99"}},
+	}
+	inConfig := []*iaas.VPCRouterStaticRoute{{Prefix: "172.17.0.0/16", NextHop: "192.168.11.98"}}
+	rs := &builder.RouterSetting{StaticRoute: inConfig}
+
+	emptyBlocks := cty.ListValEmpty(cty.EmptyObject)
+	config := cty.ObjectVal(map[string]cty.Value{
+		"dhcp_static_mapping": emptyBlocks,
+		"firewall":            emptyBlocks,
+		"port_forwarding":     cty.UnknownVal(cty.List(cty.EmptyObject)),
+		"static_nat":          cty.NullVal(cty.List(cty.EmptyObject)),
+		"static_route":        cty.ListVal([]cty.Value{cty.EmptyObjectVal}),
+	})
+	mergeVPCRouterSettingsNotInConfig(rs, config, current)
+
+	// 未指定のリストは現在の値を引き継ぐ
+	require.Equal(t, current.Firewall, rs.Firewall)
+	require.Equal(t, current.StaticNAT, rs.StaticNAT)
+	require.Equal(t, current.DHCPStaticMapping, rs.DHCPStaticMapping)
+	// 指定されている、または未確定のリストは設定の値を用いる
+	require.Nil(t, rs.PortForwarding)
+	require.Equal(t, inConfig, rs.StaticRoute)
+}
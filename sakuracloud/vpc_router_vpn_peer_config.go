@@ -0,0 +1,297 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"bytes"
+	"fmt"
+	"net/netip"
+	"strings"
+	"text/template"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+)
+
+const (
+	vpcRouterVPNPeerConfigFormatStrongSwan = "strongswan"
+	vpcRouterVPNPeerConfigFormatCiscoIOS   = "cisco_ios"
+	vpcRouterVPNPeerConfigFormatYamahaRTX  = "yamaha_rtx"
+
+	// vpcRouterVPNPreSharedSecretPlaceholder 事前共有鍵が指定されていない場合に出力する値
+	vpcRouterVPNPreSharedSecretPlaceholder = "<PRE_SHARED_SECRET>"
+)
+
+var vpcRouterVPNPeerConfigFormats = []string{
+	vpcRouterVPNPeerConfigFormatStrongSwan,
+	vpcRouterVPNPeerConfigFormatCiscoIOS,
+	vpcRouterVPNPeerConfigFormatYamahaRTX,
+}
+
+// vpcRouterVPNPeerConfig 対向機器の設定を出力するためのパラメータ
+//
+// Local/Remoteは対向機器から見た値となる
+type vpcRouterVPNPeerConfig struct {
+	LocalID         string
+	LocalPrefixes   []string
+	RemoteAddress   string
+	RemotePrefixes  []string
+	PreSharedSecret string
+	EncryptionAlgo  string
+	HashAlgo        string
+	DHGroup         string
+	IKELifetime     int
+	ESPLifetime     int
+	DPDInterval     int
+	DPDTimeout      int
+}
+
+// expandVPCRouterVPNPeerConfig VPCルータのサイト間VPN設定から指定の対向機器向けのパラメータを組み立てる
+func expandVPCRouterVPNPeerConfig(vpcRouter *iaas.VPCRouter, peer, preSharedSecret string) (*vpcRouterVPNPeerConfig, error) {
+	if vpcRouter.Settings == nil || vpcRouter.Settings.SiteToSiteIPsecVPN == nil {
+		return nil, fmt.Errorf("site-to-site VPN is not configured on VPCRouter[%s]", vpcRouter.ID)
+	}
+	s2s := vpcRouter.Settings.SiteToSiteIPsecVPN
+
+	var target *iaas.VPCRouterSiteToSiteIPsecVPNConfig
+	for _, c := range s2s.Config {
+		if c.Peer == peer {
+			target = c
+			break
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("site-to-site VPN peer %q is not found on VPCRouter[%s]", peer, vpcRouter.ID)
+	}
+
+	if preSharedSecret == "" {
+		preSharedSecret = vpcRouterVPNPreSharedSecretPlaceholder
+	}
+	localID := target.RemoteID
+	if localID == "" {
+		localID = target.Peer
+	}
+
+	// Note: パラメータ未指定の場合はVPCルータのデフォルト値を用いる
+	config := &vpcRouterVPNPeerConfig{
+		LocalID:         localID,
+		LocalPrefixes:   target.Routes,
+		RemoteAddress:   flattenVPCRouterGlobalAddress(vpcRouter),
+		RemotePrefixes:  target.LocalPrefix,
+		PreSharedSecret: preSharedSecret,
+		EncryptionAlgo:  stringOrFallback(s2s.EncryptionAlgo, types.VPCRouterSiteToSiteVPNEncryptionAlgoAES128),
+		HashAlgo:        stringOrFallback(s2s.HashAlgo, types.VPCRouterSiteToSiteVPNHashAlgoSHA1),
+		DHGroup:         stringOrFallback(s2s.DHGroup, types.VPCRouterSiteToSiteVPNDHGroupModp1024),
+		IKELifetime:     28800,
+		ESPLifetime:     1800,
+		DPDInterval:     15,
+		DPDTimeout:      30,
+	}
+	if s2s.IKE != nil {
+		config.IKELifetime = intOrFallback(s2s.IKE.Lifetime, config.IKELifetime)
+		if s2s.IKE.DPD != nil {
+			config.DPDInterval = intOrFallback(s2s.IKE.DPD.Interval, config.DPDInterval)
+			config.DPDTimeout = intOrFallback(s2s.IKE.DPD.Timeout, config.DPDTimeout)
+		}
+	}
+	if s2s.ESP != nil {
+		config.ESPLifetime = intOrFallback(s2s.ESP.Lifetime, config.ESPLifetime)
+	}
+	return config, nil
+}
+
+func stringOrFallback(v, fallback string) string {
+	if v == "" {
+		return fallback
+	}
+	return v
+}
+
+func intOrFallback(v, fallback int) int {
+	if v == 0 {
+		return fallback
+	}
+	return v
+}
+
+// renderVPCRouterVPNPeerConfig 指定のフォーマットで対向機器の設定を出力する
+func renderVPCRouterVPNPeerConfig(format string, config *vpcRouterVPNPeerConfig) (string, error) {
+	tmpl, ok := vpcRouterVPNPeerConfigTemplates[format]
+	if !ok {
+		return "", fmt.Errorf("format %q is not supported", format)
+	}
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, config); err != nil {
+		return "", fmt.Errorf("rendering VPN peer config is failed: %s", err)
+	}
+	return buf.String(), nil
+}
+
+var vpcRouterVPNPeerConfigFuncs = template.FuncMap{
+	"join": strings.Join,
+	// Cisco IOS
+	"iosEncryption": func(v string) string {
+		if v == types.VPCRouterSiteToSiteVPNEncryptionAlgoAES256 {
+			return "aes 256"
+		}
+		return "aes"
+	},
+	"iosESPEncryption": func(v string) string {
+		if v == types.VPCRouterSiteToSiteVPNEncryptionAlgoAES256 {
+			return "esp-aes 256"
+		}
+		return "esp-aes"
+	},
+	"iosHash": func(v string) string {
+		if v == types.VPCRouterSiteToSiteVPNHashAlgoSHA256 {
+			return "sha256"
+		}
+		return "sha"
+	},
+	"iosESPHash": func(v string) string {
+		if v == types.VPCRouterSiteToSiteVPNHashAlgoSHA256 {
+			return "esp-sha256-hmac"
+		}
+		return "esp-sha-hmac"
+	},
+	"iosDHGroup": func(v string) string {
+		return vpcRouterVPNDHGroupNumbers[v]
+	},
+	"iosNetwork": func(prefix string) string {
+		p, err := netip.ParsePrefix(prefix)
+		if err != nil || !p.Addr().Is4() {
+			return prefix
+		}
+		p = p.Masked()
+		wildcard := ^(uint32(0xffffffff) << (32 - p.Bits()))
+		return fmt.Sprintf("%s %d.%d.%d.%d", p.Addr(), byte(wildcard>>24), byte(wildcard>>16), byte(wildcard>>8), byte(wildcard))
+	},
+	// Yamaha RTX
+	"rtxEncryption": func(v string) string {
+		if v == types.VPCRouterSiteToSiteVPNEncryptionAlgoAES256 {
+			return "aes256-cbc"
+		}
+		return "aes-cbc"
+	},
+	"rtxHash": func(v string) string {
+		if v == types.VPCRouterSiteToSiteVPNHashAlgoSHA256 {
+			return "sha256"
+		}
+		return "sha"
+	},
+	"rtxESPHash": func(v string) string {
+		if v == types.VPCRouterSiteToSiteVPNHashAlgoSHA256 {
+			return "sha256-hmac"
+		}
+		return "sha-hmac"
+	},
+	"rtxDPDCount": func(interval, timeout int) int {
+		if interval <= 0 || timeout <= interval {
+			return 1
+		}
+		return timeout / interval
+	},
+}
+
+var vpcRouterVPNDHGroupNumbers = map[string]string{
+	types.VPCRouterSiteToSiteVPNDHGroupModp1024: "2",
+	types.VPCRouterSiteToSiteVPNDHGroupModp2048: "14",
+	types.VPCRouterSiteToSiteVPNDHGroupModp3072: "15",
+	types.VPCRouterSiteToSiteVPNDHGroupModp4096: "16",
+}
+
+var vpcRouterVPNPeerConfigTemplates = map[string]*template.Template{
+	vpcRouterVPNPeerConfigFormatStrongSwan: template.Must(template.New(vpcRouterVPNPeerConfigFormatStrongSwan).Funcs(vpcRouterVPNPeerConfigFuncs).Parse(`connections {
+  sakuracloud {
+    version = 1
+    remote_addrs = {{ .RemoteAddress }}
+    proposals = {{ .EncryptionAlgo }}-{{ .HashAlgo }}-{{ .DHGroup }}
+    rekey_time = {{ .IKELifetime }}s
+    dpd_delay = {{ .DPDInterval }}s
+    dpd_timeout = {{ .DPDTimeout }}s
+    local {
+      auth = psk
+      id = {{ .LocalID }}
+    }
+    remote {
+      auth = psk
+      id = {{ .RemoteAddress }}
+    }
+    children {
+      sakuracloud {
+        local_ts = {{ join .LocalPrefixes ", " }}
+        remote_ts = {{ join .RemotePrefixes ", " }}
+        esp_proposals = {{ .EncryptionAlgo }}-{{ .HashAlgo }}
+        rekey_time = {{ .ESPLifetime }}s
+        dpd_action = restart
+        start_action = start
+      }
+    }
+  }
+}
+
+secrets {
+  ike-sakuracloud {
+    id = {{ .RemoteAddress }}
+    secret = "{{ .PreSharedSecret }}"
+  }
+}
+`)),
+	vpcRouterVPNPeerConfigFormatCiscoIOS: template.Must(template.New(vpcRouterVPNPeerConfigFormatCiscoIOS).Funcs(vpcRouterVPNPeerConfigFuncs).Parse(`crypto isakmp policy 10
+ encryption {{ iosEncryption .EncryptionAlgo }}
+ hash {{ iosHash .HashAlgo }}
+ authentication pre-share
+ group {{ iosDHGroup .DHGroup }}
+ lifetime {{ .IKELifetime }}
+crypto isakmp identity address
+crypto isakmp key {{ .PreSharedSecret }} address {{ .RemoteAddress }}
+crypto isakmp keepalive {{ .DPDInterval }}
+!
+crypto ipsec transform-set SAKURACLOUD {{ iosESPEncryption .EncryptionAlgo }} {{ iosESPHash .HashAlgo }}
+ mode tunnel
+!
+ip access-list extended SAKURACLOUD-VPN
+{{- range $local := .LocalPrefixes }}{{ range $remote := $.RemotePrefixes }}
+ permit ip {{ iosNetwork $local }} {{ iosNetwork $remote }}
+{{- end }}{{ end }}
+!
+crypto map SAKURACLOUD 10 ipsec-isakmp
+ set peer {{ .RemoteAddress }}
+ set transform-set SAKURACLOUD
+ set security-association lifetime seconds {{ .ESPLifetime }}
+ match address SAKURACLOUD-VPN
+!
+`)),
+	vpcRouterVPNPeerConfigFormatYamahaRTX: template.Must(template.New(vpcRouterVPNPeerConfigFormatYamahaRTX).Funcs(vpcRouterVPNPeerConfigFuncs).Parse(`tunnel select 1
+ ipsec tunnel 101
+  ipsec sa policy 101 1 esp {{ rtxEncryption .EncryptionAlgo }} {{ rtxESPHash .HashAlgo }}
+  ipsec ike duration ipsec-sa 1 {{ .ESPLifetime }}
+  ipsec ike duration isakmp-sa 1 {{ .IKELifetime }}
+  ipsec ike encryption 1 {{ rtxEncryption .EncryptionAlgo }}
+  ipsec ike group 1 {{ .DHGroup }}
+  ipsec ike hash 1 {{ rtxHash .HashAlgo }}
+  ipsec ike keepalive use 1 on dpd {{ .DPDInterval }} {{ rtxDPDCount .DPDInterval .DPDTimeout }}
+  ipsec ike local name 1 {{ .LocalID }} ipv4-addr
+  ipsec ike pre-shared-key 1 text {{ .PreSharedSecret }}
+  ipsec ike remote address 1 {{ .RemoteAddress }}
+  ipsec ike remote name 1 {{ .RemoteAddress }} ipv4-addr
+ ip tunnel tcp mss limit auto
+ tunnel enable 1
+{{- range .RemotePrefixes }}
+ip route {{ . }} gateway tunnel 1
+{{- end }}
+ipsec auto refresh on
+`)),
+}
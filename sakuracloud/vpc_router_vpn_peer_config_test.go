@@ -0,0 +1,147 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"testing"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testVPCRouterWithSiteToSiteVPN() *iaas.VPCRouter {
+	return &iaas.VPCRouter{
+		ID:     types.ID(123456789012),
+		PlanID: types.VPCRouterPlans.Standard,
+		Interfaces: []*iaas.VPCRouterInterface{
+			{IPAddress: "203.0.113.10"},
+		},
+		Settings: &iaas.VPCRouterSetting{
+			SiteToSiteIPsecVPN: &iaas.VPCRouterSiteToSiteIPsecVPN{
+				Config: []*iaas.VPCRouterSiteToSiteIPsecVPNConfig{
+					{
+						Peer:        "198.51.100.1",
+						RemoteID:    "198.51.100.1",
+						Routes:      []string{"10.0.0.0/8"},
+						LocalPrefix: []string{"192.168.21.0/24"},
+					},
+				},
+				IKE: &iaas.VPCRouterSiteToSiteIPsecVPNIKE{
+					Lifetime: 28800,
+					DPD:      &iaas.VPCRouterSiteToSiteIPsecVPNIKEDPD{Interval: 15, Timeout: 30},
+				},
+				ESP:            &iaas.VPCRouterSiteToSiteIPsecVPNESP{Lifetime: 1800},
+				EncryptionAlgo: "aes256",
+				HashAlgo:       "sha256",
+				DHGroup:        "modp2048",
+			},
+		},
+	}
+}
+
+func TestExpandVPCRouterVPNPeerConfig(t *testing.T) {
+	vpcRouter := testVPCRouterWithSiteToSiteVPN()
+
+	config, err := expandVPCRouterVPNPeerConfig(vpcRouter, "198.51.100.1", "")
+	require.NoError(t, err)
+	require.Equal(t, &vpcRouterVPNPeerConfig{
+		LocalID:         "198.51.100.1",
+		LocalPrefixes:   []string{"10.0.0.0/8"},
+		RemoteAddress:   "203.0.113.10",
+		RemotePrefixes:  []string{"192.168.21.0/24"},
+		PreSharedSecret: vpcRouterVPNPreSharedSecretPlaceholder,
+		EncryptionAlgo:  "aes256",
+		HashAlgo:        "sha256",
+		DHGroup:         "modp2048",
+		IKELifetime:     28800,
+		ESPLifetime:     1800,
+		DPDInterval:     15,
+		DPDTimeout:      30,
+	}, config)
+
+	_, err = expandVPCRouterVPNPeerConfig(vpcRouter, "198.51.100.2", "")
+	require.Error(t, err)
+
+	// パラメータ未指定の場合はデフォルト値となる
+	vpcRouter.Settings.SiteToSiteIPsecVPN = &iaas.VPCRouterSiteToSiteIPsecVPN{
+		Config: vpcRouter.Settings.SiteToSiteIPsecVPN.Config,
+	}
+	config, err = expandVPCRouterVPNPeerConfig(vpcRouter, "198.51.100.1", "secret")
+	require.NoError(t, err)
+	require.Equal(t, "secret", config.PreSharedSecret)
+	require.Equal(t, "aes128", config.EncryptionAlgo)
+	require.Equal(t, "sha1", config.HashAlgo)
+	require.Equal(t, "modp1024", config.DHGroup)
+	require.Equal(t, 28800, config.IKELifetime)
+	require.Equal(t, 1800, config.ESPLifetime)
+}
+
+func TestRenderVPCRouterVPNPeerConfig(t *testing.T) {
+	config, err := expandVPCRouterVPNPeerConfig(testVPCRouterWithSiteToSiteVPN(), "198.51.100.1", "secret")
+	require.NoError(t, err)
+
+	cases := []struct {
+		format string
+		want   []string
+	}{
+		{
+			format: vpcRouterVPNPeerConfigFormatStrongSwan,
+			want: []string{
+				"    remote_addrs = 203.0.113.10\n",
+				"    proposals = aes256-sha256-modp2048\n",
+				"      id = 198.51.100.1\n",
+				"        local_ts = 10.0.0.0/8\n",
+				"        remote_ts = 192.168.21.0/24\n",
+				"        esp_proposals = aes256-sha256\n",
+				"    secret = \"secret\"\n",
+			},
+		},
+		{
+			format: vpcRouterVPNPeerConfigFormatCiscoIOS,
+			want: []string{
+				" encryption aes 256\n",
+				" hash sha256\n",
+				" group 14\n",
+				"crypto isakmp key secret address 203.0.113.10\n",
+				"crypto ipsec transform-set SAKURACLOUD esp-aes 256 esp-sha256-hmac\n",
+				" permit ip 10.0.0.0 0.255.255.255 192.168.21.0 0.0.0.255\n",
+				" set security-association lifetime seconds 1800\n",
+			},
+		},
+		{
+			format: vpcRouterVPNPeerConfigFormatYamahaRTX,
+			want: []string{
+				"  ipsec sa policy 101 1 esp aes256-cbc sha256-hmac\n",
+				"  ipsec ike group 1 modp2048\n",
+				"  ipsec ike keepalive use 1 on dpd 15 2\n",
+				"  ipsec ike pre-shared-key 1 text secret\n",
+				"  ipsec ike remote address 1 203.0.113.10\n",
+				"ip route 192.168.21.0/24 gateway tunnel 1\n",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := renderVPCRouterVPNPeerConfig(tc.format, config)
+		require.NoError(t, err, tc.format)
+		for _, want := range tc.want {
+			require.Contains(t, got, want, tc.format)
+		}
+	}
+
+	_, err = renderVPCRouterVPNPeerConfig("unknown", config)
+	require.Error(t, err)
+}
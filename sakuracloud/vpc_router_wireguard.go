@@ -0,0 +1,226 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sacloud/iaas-api-go"
+)
+
+const (
+	// vpcRouterWireGuardListenPort VPCルータのWireGuardサーバが待ち受けるポート番号
+	vpcRouterWireGuardListenPort = 51820
+	// vpcRouterWireGuardPrivateKeyPlaceholder 秘密鍵をプロバイダーで生成していない場合にclient_configへ出力する値
+	vpcRouterWireGuardPrivateKeyPlaceholder = "<YOUR_PRIVATE_KEY>"
+)
+
+// vpcRouterWireGuardPeerKey generate_key=trueなピアに対しローカルで生成した鍵ペア
+type vpcRouterWireGuardPeerKey struct {
+	privateKey string
+	publicKey  string
+}
+
+// generateWireGuardKeyPair WireGuardで利用するX25519の鍵ペアをbase64エンコードした形で生成する
+func generateWireGuardKeyPair() (privateKey, publicKey string, err error) {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return "", "", err
+	}
+	// wg genkeyと同様にclampしておく
+	seed[0] &= 248
+	seed[31] = (seed[31] & 127) | 64
+
+	key, err := ecdh.X25519().NewPrivateKey(seed)
+	if err != nil {
+		return "", "", err
+	}
+	return base64.StdEncoding.EncodeToString(key.Bytes()),
+		base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()),
+		nil
+}
+
+// generateVPCRouterWireGuardPeerKeys generate_key=trueなピアの鍵ペアを生成しwire_guardへ反映する
+//
+// 同じ名前のピアに対し生成済みの鍵ペアがある場合はそれを引き継ぐ
+func generateVPCRouterWireGuardPeerKeys(d *schema.ResourceData) error {
+	values, ok := getListFromResource(d, "wire_guard")
+	if !ok || len(values) == 0 || values[0] == nil {
+		return nil
+	}
+	wireGuard := values[0].(map[string]interface{})
+	peers, _ := wireGuard["peer"].([]interface{})
+
+	generated := make(map[string]*vpcRouterWireGuardPeerKey)
+	o, _ := d.GetChange("wire_guard")
+	if oldValues, ok := o.([]interface{}); ok && len(oldValues) > 0 && oldValues[0] != nil {
+		oldPeers, _ := oldValues[0].(map[string]interface{})["peer"].([]interface{})
+		for _, raw := range oldPeers {
+			v := mapToResourceData(raw.(map[string]interface{}))
+			if privateKey := stringOrDefault(v, "private_key"); privateKey != "" {
+				generated[stringOrDefault(v, "name")] = &vpcRouterWireGuardPeerKey{
+					privateKey: privateKey,
+					publicKey:  stringOrDefault(v, "public_key"),
+				}
+			}
+		}
+	}
+
+	for _, raw := range peers {
+		peer := raw.(map[string]interface{})
+		if !boolOrDefault(mapToResourceData(peer), "generate_key") {
+			peer["private_key"] = ""
+			continue
+		}
+		key, ok := generated[peer["name"].(string)]
+		if !ok {
+			privateKey, publicKey, err := generateWireGuardKeyPair()
+			if err != nil {
+				return fmt.Errorf("generating WireGuard key pair for peer %q is failed: %s", peer["name"], err)
+			}
+			key = &vpcRouterWireGuardPeerKey{privateKey: privateKey, publicKey: publicKey}
+		}
+		peer["private_key"] = key.privateKey
+		peer["public_key"] = key.publicKey
+	}
+	return d.Set("wire_guard", values)
+}
+
+// expandVPCRouterWireGuardPeerKeys stateに保持しているgenerate_key=trueなピアの鍵ペアを公開鍵をキーとして返す
+func expandVPCRouterWireGuardPeerKeys(d resourceValueGettable) map[string]*vpcRouterWireGuardPeerKey {
+	keys := make(map[string]*vpcRouterWireGuardPeerKey)
+	if values, ok := getListFromResource(d, "wire_guard"); ok && len(values) > 0 && values[0] != nil {
+		d := mapToResourceData(values[0].(map[string]interface{}))
+		if peerValues, ok := getListFromResource(d, "peer"); ok {
+			for _, raw := range peerValues {
+				v := mapToResourceData(raw.(map[string]interface{}))
+				if boolOrDefault(v, "generate_key") && stringOrDefault(v, "private_key") != "" {
+					publicKey := stringOrDefault(v, "public_key")
+					keys[publicKey] = &vpcRouterWireGuardPeerKey{
+						privateKey: stringOrDefault(v, "private_key"),
+						publicKey:  publicKey,
+					}
+				}
+			}
+		}
+	}
+	return keys
+}
+
+// flattenVPCRouterWireGuardPeerConfigs flattenVPCRouterWireGuardの結果に対しピアごとの鍵やclient_configを追加する
+func flattenVPCRouterWireGuardPeerConfigs(vpcRouter *iaas.VPCRouter, wireGuard []interface{}, keys map[string]*vpcRouterWireGuardPeerKey) []interface{} {
+	if len(wireGuard) == 0 || wireGuard[0] == nil {
+		return wireGuard
+	}
+	v := wireGuard[0].(map[string]interface{})
+	serverPublicKey, _ := v["public_key"].(string)
+	serverAddress, _ := v["ip_address"].(string)
+	allowedIPs := vpcRouterWireGuardAllowedIPs(vpcRouter, serverAddress)
+	endpoint := fmt.Sprintf("%s:%d", flattenVPCRouterGlobalAddress(vpcRouter), vpcRouterWireGuardListenPort)
+
+	peers, _ := v["peer"].([]interface{})
+	for _, raw := range peers {
+		peer := raw.(map[string]interface{})
+		privateKey := ""
+		if key, ok := keys[peer["public_key"].(string)]; ok {
+			privateKey = key.privateKey
+		}
+		peer["generate_key"] = privateKey != ""
+		peer["private_key"] = privateKey
+		peer["client_config"] = renderWireGuardClientConfig(privateKey, peer["ip_address"].(string), serverPublicKey, endpoint, allowedIPs)
+	}
+	return wireGuard
+}
+
+// vpcRouterWireGuardAllowedIPs WireGuardクライアントからVPCルータ経由で到達可能なネットワークのリストを返す
+func vpcRouterWireGuardAllowedIPs(vpcRouter *iaas.VPCRouter, serverAddress string) []string {
+	var allowedIPs []string
+	if prefix, err := netip.ParsePrefix(serverAddress); err == nil {
+		allowedIPs = append(allowedIPs, prefix.Masked().String())
+	}
+	if vpcRouter.Settings == nil {
+		return allowedIPs
+	}
+	for _, iface := range vpcRouter.Settings.Interfaces {
+		if iface.Index == 0 || len(iface.IPAddress) == 0 {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(fmt.Sprintf("%s/%d", iface.IPAddress[0], iface.NetworkMaskLen))
+		if err != nil {
+			continue
+		}
+		allowedIPs = append(allowedIPs, prefix.Masked().String())
+	}
+	return allowedIPs
+}
+
+// renderWireGuardClientConfig wg-quickで利用可能な形式のクライアント設定を組み立てる
+func renderWireGuardClientConfig(privateKey, address, serverPublicKey, endpoint string, allowedIPs []string) string {
+	if privateKey == "" {
+		privateKey = vpcRouterWireGuardPrivateKeyPlaceholder
+	}
+	if !strings.Contains(address, "/") {
+		address += "/32"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("[Interface]\n")
+	fmt.Fprintf(&sb, "PrivateKey = %s\n", privateKey)
+	fmt.Fprintf(&sb, "Address = %s\n", address)
+	sb.WriteString("\n")
+	sb.WriteString("[Peer]\n")
+	fmt.Fprintf(&sb, "PublicKey = %s\n", serverPublicKey)
+	fmt.Fprintf(&sb, "Endpoint = %s\n", endpoint)
+	fmt.Fprintf(&sb, "AllowedIPs = %s\n", strings.Join(allowedIPs, ", "))
+	return sb.String()
+}
+
+func resourceSakuraCloudVPCRouterWireGuardCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return validateVPCRouterWireGuardPeers(d.GetRawConfig())
+}
+
+// validateVPCRouterWireGuardPeers 各ピアでpublic_keyとgenerate_keyのいずれか一方のみが指定されているか検証する
+func validateVPCRouterWireGuardPeers(config cty.Value) error {
+	var messages []string
+	for i, wireGuard := range ctyListElements(config, "wire_guard") {
+		for j, peer := range ctyListElements(wireGuard, "peer") {
+			publicKey, publicKeyKnown := ctyString(peer, "public_key")
+			generateKey, generateKeyKnown := ctyBool(peer, "generate_key")
+			if !publicKeyKnown || !generateKeyKnown {
+				continue
+			}
+			path := fmt.Sprintf("wire_guard.%d.peer.%d", i, j)
+			switch {
+			case generateKey && publicKey != "":
+				messages = append(messages, fmt.Sprintf("%s: public_key cannot be specified when generate_key is true", path))
+			case !generateKey && publicKey == "":
+				messages = append(messages, fmt.Sprintf("%s: either public_key or generate_key = true is required", path))
+			}
+		}
+	}
+	if len(messages) > 0 {
+		return errors.New(strings.Join(messages, "\n"))
+	}
+	return nil
+}
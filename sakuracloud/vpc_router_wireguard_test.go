@@ -0,0 +1,151 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"crypto/ecdh"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateWireGuardKeyPair(t *testing.T) {
+	privateKey, publicKey, err := generateWireGuardKeyPair()
+	require.NoError(t, err)
+
+	privateBytes, err := base64.StdEncoding.DecodeString(privateKey)
+	require.NoError(t, err)
+	require.Len(t, privateBytes, 32)
+
+	publicBytes, err := base64.StdEncoding.DecodeString(publicKey)
+	require.NoError(t, err)
+	require.Len(t, publicBytes, 32)
+
+	key, err := ecdh.X25519().NewPrivateKey(privateBytes)
+	require.NoError(t, err)
+	require.Equal(t, publicBytes, key.PublicKey().Bytes())
+
+	another, _, err := generateWireGuardKeyPair()
+	require.NoError(t, err)
+	require.NotEqual(t, privateKey, another)
+}
+
+func TestFlattenVPCRouterWireGuardPeerConfigs(t *testing.T) {
+	vpcRouter := &iaas.VPCRouter{
+		PlanID: types.VPCRouterPlans.Premium,
+		Settings: &iaas.VPCRouterSetting{
+			Interfaces: []*iaas.VPCRouterInterfaceSetting{
+				{Index: 0, VirtualIPAddress: "203.0.113.10", IPAddress: []string{"203.0.113.11", "203.0.113.12"}, NetworkMaskLen: 28},
+				{Index: 1, VirtualIPAddress: "192.168.11.1", IPAddress: []string{"192.168.11.2", "192.168.11.3"}, NetworkMaskLen: 24},
+			},
+		},
+	}
+	wireGuard := []interface{}{
+		map[string]interface{}{
+			"ip_address": "192.168.31.1/24",
+			"public_key": "server-public-key",
+			"peer": []interface{}{
+				map[string]interface{}{
+					"name":       "generated",
+					"ip_address": "192.168.31.11",
+					"public_key": "generated-public-key",
+				},
+				map[string]interface{}{
+					"name":       "byo",
+					"ip_address": "192.168.31.12",
+					"public_key": "byo-public-key",
+				},
+			},
+		},
+	}
+	keys := map[string]*vpcRouterWireGuardPeerKey{
+		"generated-public-key": {privateKey: "generated-private-key", publicKey: "generated-public-key"},
+	}
+
+	result := flattenVPCRouterWireGuardPeerConfigs(vpcRouter, wireGuard, keys)
+	peers := result[0].(map[string]interface{})["peer"].([]interface{})
+
+	generated := peers[0].(map[string]interface{})
+	require.Equal(t, true, generated["generate_key"])
+	require.Equal(t, "generated-private-key", generated["private_key"])
+	require.Equal(t, `[Interface]
+PrivateKey = generated-private-key
+Address = 192.168.31.11/32
+
+[Peer]
+PublicKey = server-public-key
+Endpoint = 203.0.113.10:51820
+AllowedIPs = 192.168.31.0/24, 192.168.11.0/24
+`, generated["client_config"])
+
+	byo := peers[1].(map[string]interface{})
+	require.Equal(t, false, byo["generate_key"])
+	require.Equal(t, "", byo["private_key"])
+	require.Contains(t, byo["client_config"], "PrivateKey = "+vpcRouterWireGuardPrivateKeyPlaceholder+"\n")
+}
+
+func TestValidateVPCRouterWireGuardPeers(t *testing.T) {
+	peer := func(publicKey, generateKey cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name":         cty.StringVal("peer"),
+			"ip_address":   cty.StringVal("192.168.31.11"),
+			"public_key":   publicKey,
+			"generate_key": generateKey,
+		})
+	}
+	config := func(peers ...cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"wire_guard": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"ip_address": cty.StringVal("192.168.31.1/24"),
+					"peer":       cty.ListVal(peers),
+				}),
+			}),
+		})
+	}
+
+	cases := []struct {
+		msg     string
+		in      cty.Value
+		wantErr string
+	}{
+		{msg: "public_key", in: config(peer(cty.StringVal("key"), cty.NullVal(cty.Bool)))},
+		{msg: "generate_key", in: config(peer(cty.NullVal(cty.String), cty.True))},
+		{msg: "unknown public_key", in: config(peer(cty.UnknownVal(cty.String), cty.False))},
+		{
+			msg:     "both",
+			in:      config(peer(cty.StringVal("key"), cty.True)),
+			wantErr: "wire_guard.0.peer.0: public_key cannot be specified when generate_key is true",
+		},
+		{
+			msg:     "neither",
+			in:      config(peer(cty.NullVal(cty.String), cty.False)),
+			wantErr: "wire_guard.0.peer.0: either public_key or generate_key = true is required",
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateVPCRouterWireGuardPeers(tc.in)
+		if tc.wantErr == "" {
+			require.NoError(t, err, tc.msg)
+			continue
+		}
+		require.EqualError(t, err, tc.wantErr, tc.msg)
+	}
+}
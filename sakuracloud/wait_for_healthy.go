@@ -0,0 +1,233 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+)
+
+// waitForHealthyInterval ヘルスチェック結果を確認する間隔
+var waitForHealthyInterval = 10 * time.Second
+
+type waitForHealthyParameter struct {
+	timeout         time.Duration
+	minHealthyCount int
+}
+
+// backendHealthGroup ヘルスチェック結果をまとめる単位(ロードバランサの場合はVIPごと)
+type backendHealthGroup struct {
+	name     string
+	expected []string // 設定されている有効な実サーバ("IPアドレス:ポート")
+	servers  []*iaas.LoadBalancerServerStatus
+}
+
+func (g *backendHealthGroup) status(address string) *iaas.LoadBalancerServerStatus {
+	for _, s := range g.servers {
+		if backendServerAddress(s.IPAddress, s.Port.String()) == address {
+			return s
+		}
+	}
+	return nil
+}
+
+// healthyCount 設定されている実サーバのうちUPとなっている数
+func (g *backendHealthGroup) healthyCount() int {
+	count := 0
+	for _, address := range g.expected {
+		if s := g.status(address); s != nil && s.Status.IsUp() {
+			count++
+		}
+	}
+	return count
+}
+
+func backendServerAddress(ip, port string) string {
+	return fmt.Sprintf("%s:%s", ip, port)
+}
+
+func expandWaitForHealthy(d resourceValueGettable) *waitForHealthyParameter {
+	if values, ok := getListFromResource(d, "wait_for_healthy"); ok && len(values) > 0 {
+		raw, ok := values[0].(map[string]interface{})
+		if !ok {
+			// 全ての項目が省略された場合はデフォルト値を用いる
+			raw = map[string]interface{}{}
+		}
+		v := mapToResourceData(raw)
+		return &waitForHealthyParameter{
+			timeout:         time.Duration(intOrDefault(v, "timeout_sec")) * time.Second,
+			minHealthyCount: intOrDefault(v, "min_healthy_count"),
+		}
+	}
+	return nil
+}
+
+// waitForBackendsHealthy readerで取得したヘルスチェック結果が全てのグループでminHealthyCount以上のUPとなるまで待つ
+//
+// 取得時のエラーや実サーバが未設定の状態(サブリソースによる追加待ちなど)はタイムアウトまで待ち続ける。
+// タイムアウトした場合は最後に取得した実サーバごとの状態と最後のエラーをエラーに含める
+func waitForBackendsHealthy(ctx context.Context, param *waitForHealthyParameter, reader func(ctx context.Context) ([]*backendHealthGroup, error)) error {
+	if param == nil {
+		return nil
+	}
+	if param.timeout <= 0 {
+		param.timeout = 300 * time.Second
+	}
+	if param.minHealthyCount <= 0 {
+		param.minHealthyCount = 1
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, param.timeout)
+	defer cancel()
+
+	var groups []*backendHealthGroup
+	var lastErr error
+	for {
+		current, err := reader(waitCtx)
+		switch {
+		case err != nil && iaas.IsNotFoundError(err):
+			return err
+		case err != nil:
+			if waitCtx.Err() == nil {
+				lastErr = err
+			}
+		default:
+			groups = current
+			lastErr = nil
+			if isBackendsHealthy(groups, param.minHealthyCount) {
+				return nil
+			}
+		}
+
+		select {
+		case <-waitCtx.Done():
+			report := backendHealthReport(groups, param.minHealthyCount)
+			if lastErr != nil {
+				report += fmt.Sprintf("\n  last error: %s", lastErr)
+			}
+			return fmt.Errorf("servers did not become healthy within %s:\n%s", param.timeout, report)
+		case <-time.After(waitForHealthyInterval):
+		}
+	}
+}
+
+// isBackendsHealthy 実サーバが設定された全てのグループで、全ての実サーバのヘルスチェック結果が返されminHealthyCount以上がUPとなっているか
+//
+// 作成/更新直後はヘルスチェック結果が空となることがあるため、結果が返されていない実サーバがあれば未完了とみなす。
+// 実サーバがいずれのグループにも設定されていない場合も未完了とみなす
+func isBackendsHealthy(groups []*backendHealthGroup, minHealthyCount int) bool {
+	if !hasBackendServers(groups) {
+		return false
+	}
+	for _, g := range groups {
+		for _, address := range g.expected {
+			if g.status(address) == nil {
+				return false
+			}
+		}
+		if len(g.expected) > 0 && g.healthyCount() < minHealthyCount {
+			return false
+		}
+	}
+	return true
+}
+
+func hasBackendServers(groups []*backendHealthGroup) bool {
+	for _, g := range groups {
+		if len(g.expected) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func backendHealthReport(groups []*backendHealthGroup, minHealthyCount int) string {
+	if len(groups) == 0 {
+		return "  (no health status was returned)"
+	}
+	if !hasBackendServers(groups) {
+		return "  (no enabled servers are configured)"
+	}
+	var lines []string
+	for _, g := range groups {
+		lines = append(lines, fmt.Sprintf("  %s: %d/%d healthy (required: %d)", g.name, g.healthyCount(), len(g.expected), minHealthyCount))
+		for _, address := range g.expected {
+			status := "not reported"
+			if s := g.status(address); s != nil {
+				status = string(s.Status)
+				if status == "" {
+					status = "unknown"
+				}
+			}
+			lines = append(lines, fmt.Sprintf("    - %s %s", address, status))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func proxyLBBackendHealthReader(proxyLBOp iaas.ProxyLBAPI, id types.ID) func(ctx context.Context) ([]*backendHealthGroup, error) {
+	return func(ctx context.Context) ([]*backendHealthGroup, error) {
+		proxyLB, err := proxyLBOp.Read(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		health, err := proxyLBOp.HealthStatus(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		group := &backendHealthGroup{name: fmt.Sprintf("ProxyLB[%s]", id), servers: health.Servers}
+		for _, server := range proxyLB.Servers {
+			if server.Enabled {
+				group.expected = append(group.expected, backendServerAddress(server.IPAddress, fmt.Sprintf("%d", server.Port)))
+			}
+		}
+		return []*backendHealthGroup{group}, nil
+	}
+}
+
+func loadBalancerBackendHealthReader(lbOp iaas.LoadBalancerAPI, zone string, id types.ID) func(ctx context.Context) ([]*backendHealthGroup, error) {
+	return func(ctx context.Context) ([]*backendHealthGroup, error) {
+		lb, err := lbOp.Read(ctx, zone, id)
+		if err != nil {
+			return nil, err
+		}
+		result, err := lbOp.Status(ctx, zone, id)
+		if err != nil {
+			return nil, err
+		}
+
+		var groups []*backendHealthGroup
+		for _, vip := range lb.VirtualIPAddresses {
+			group := &backendHealthGroup{name: fmt.Sprintf("VIP %s:%s", vip.VirtualIPAddress, vip.Port.String())}
+			for _, s := range result.Status {
+				if s.VirtualIPAddress == vip.VirtualIPAddress && s.Port.Int() == vip.Port.Int() {
+					group.servers = s.Servers
+				}
+			}
+			for _, server := range vip.Servers {
+				if server.Enabled.Bool() {
+					group.expected = append(group.expected, backendServerAddress(server.IPAddress, server.Port.String()))
+				}
+			}
+			groups = append(groups, group)
+		}
+		return groups, nil
+	}
+}
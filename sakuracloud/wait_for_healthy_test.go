@@ -0,0 +1,192 @@
+// Copyright 2016-2025 terraform-provider-sakuracloud authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sakuracloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/iaas-api-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testBackendServerStatus(ip string, status types.EServerInstanceStatus) *iaas.LoadBalancerServerStatus {
+	return &iaas.LoadBalancerServerStatus{
+		IPAddress: ip,
+		Port:      types.StringNumber(80),
+		Status:    status,
+	}
+}
+
+func TestWaitForBackendsHealthy(t *testing.T) {
+	defer func(interval time.Duration) { waitForHealthyInterval = interval }(waitForHealthyInterval)
+	waitForHealthyInterval = time.Millisecond
+
+	t.Run("nil parameter", func(t *testing.T) {
+		err := waitForBackendsHealthy(context.Background(), nil, func(ctx context.Context) ([]*backendHealthGroup, error) {
+			t.Fatal("reader should not be called")
+			return nil, nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("become healthy", func(t *testing.T) {
+		called := 0
+		err := waitForBackendsHealthy(context.Background(), &waitForHealthyParameter{timeout: time.Second, minHealthyCount: 2},
+			func(ctx context.Context) ([]*backendHealthGroup, error) {
+				called++
+				status := types.ServerInstanceStatuses.Down
+				if called >= 3 {
+					status = types.ServerInstanceStatuses.Up
+				}
+				return []*backendHealthGroup{
+					{
+						name:     "VIP 192.0.2.101:80",
+						expected: []string{"192.0.2.11:80", "192.0.2.12:80"},
+						servers: []*iaas.LoadBalancerServerStatus{
+							testBackendServerStatus("192.0.2.11", types.ServerInstanceStatuses.Up),
+							testBackendServerStatus("192.0.2.12", status),
+						},
+					},
+					// 実サーバが設定されていないVIPは待つ対象としない
+					{name: "VIP 192.0.2.102:80"},
+				}, nil
+			})
+		require.NoError(t, err)
+		require.Equal(t, 3, called)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		err := waitForBackendsHealthy(context.Background(), &waitForHealthyParameter{timeout: 10 * time.Millisecond, minHealthyCount: 1},
+			func(ctx context.Context) ([]*backendHealthGroup, error) {
+				return []*backendHealthGroup{
+					{
+						name:     "ProxyLB[123456789012]",
+						expected: []string{"192.0.2.11:80", "192.0.2.12:80"},
+						servers: []*iaas.LoadBalancerServerStatus{
+							testBackendServerStatus("192.0.2.11", types.ServerInstanceStatuses.Down),
+							testBackendServerStatus("192.0.2.12", types.ServerInstanceStatuses.Unknown),
+						},
+					},
+				}, nil
+			})
+		require.EqualError(t, err, `servers did not become healthy within 10ms:
+  ProxyLB[123456789012]: 0/2 healthy (required: 1)
+    - 192.0.2.11:80 down
+    - 192.0.2.12:80 unknown`)
+	})
+
+	t.Run("empty health status", func(t *testing.T) {
+		called := 0
+		err := waitForBackendsHealthy(context.Background(), &waitForHealthyParameter{timeout: time.Second, minHealthyCount: 1},
+			func(ctx context.Context) ([]*backendHealthGroup, error) {
+				called++
+				group := &backendHealthGroup{
+					name:     "ProxyLB[123456789012]",
+					expected: []string{"192.0.2.11:80", "192.0.2.12:80"},
+				}
+				// 作成直後はヘルスチェック結果が返されない、または一部のみ返される
+				switch {
+				case called == 2:
+					group.servers = []*iaas.LoadBalancerServerStatus{
+						testBackendServerStatus("192.0.2.11", types.ServerInstanceStatuses.Up),
+					}
+				case called >= 3:
+					group.servers = []*iaas.LoadBalancerServerStatus{
+						testBackendServerStatus("192.0.2.11", types.ServerInstanceStatuses.Up),
+						testBackendServerStatus("192.0.2.12", types.ServerInstanceStatuses.Down),
+					}
+				}
+				return []*backendHealthGroup{group}, nil
+			})
+		require.NoError(t, err)
+		require.Equal(t, 3, called)
+	})
+
+	t.Run("timeout with empty health status", func(t *testing.T) {
+		err := waitForBackendsHealthy(context.Background(), &waitForHealthyParameter{timeout: 10 * time.Millisecond, minHealthyCount: 1},
+			func(ctx context.Context) ([]*backendHealthGroup, error) {
+				return []*backendHealthGroup{
+					{name: "VIP 192.0.2.101:80", expected: []string{"192.0.2.11:80"}},
+				}, nil
+			})
+		require.EqualError(t, err, `servers did not become healthy within 10ms:
+  VIP 192.0.2.101:80: 0/1 healthy (required: 1)
+    - 192.0.2.11:80 not reported`)
+	})
+
+	t.Run("no servers yet", func(t *testing.T) {
+		called := 0
+		err := waitForBackendsHealthy(context.Background(), &waitForHealthyParameter{timeout: time.Second, minHealthyCount: 1},
+			func(ctx context.Context) ([]*backendHealthGroup, error) {
+				called++
+				group := &backendHealthGroup{name: "ProxyLB[123456789012]"}
+				// 実サーバはsakuracloud_proxylb_serverなどにより後から追加される
+				if called >= 3 {
+					group.expected = []string{"192.0.2.11:80"}
+					group.servers = []*iaas.LoadBalancerServerStatus{
+						testBackendServerStatus("192.0.2.11", types.ServerInstanceStatuses.Up),
+					}
+				}
+				return []*backendHealthGroup{group}, nil
+			})
+		require.NoError(t, err)
+		require.Equal(t, 3, called)
+	})
+
+	t.Run("timeout with no servers", func(t *testing.T) {
+		err := waitForBackendsHealthy(context.Background(), &waitForHealthyParameter{timeout: 10 * time.Millisecond, minHealthyCount: 1},
+			func(ctx context.Context) ([]*backendHealthGroup, error) {
+				return []*backendHealthGroup{{name: "ProxyLB[123456789012]"}}, nil
+			})
+		require.EqualError(t, err, `servers did not become healthy within 10ms:
+  (no enabled servers are configured)`)
+	})
+
+	t.Run("retry on error", func(t *testing.T) {
+		called := 0
+		err := waitForBackendsHealthy(context.Background(), &waitForHealthyParameter{timeout: time.Second, minHealthyCount: 1},
+			func(ctx context.Context) ([]*backendHealthGroup, error) {
+				called++
+				if called < 3 {
+					return nil, errors.New("503 Service Unavailable")
+				}
+				return []*backendHealthGroup{
+					{
+						name:     "ProxyLB[123456789012]",
+						expected: []string{"192.0.2.11:80"},
+						servers: []*iaas.LoadBalancerServerStatus{
+							testBackendServerStatus("192.0.2.11", types.ServerInstanceStatuses.Up),
+						},
+					},
+				}, nil
+			})
+		require.NoError(t, err)
+		require.Equal(t, 3, called)
+	})
+
+	t.Run("timeout with error", func(t *testing.T) {
+		err := waitForBackendsHealthy(context.Background(), &waitForHealthyParameter{timeout: 10 * time.Millisecond, minHealthyCount: 1},
+			func(ctx context.Context) ([]*backendHealthGroup, error) {
+				return nil, errors.New("503 Service Unavailable")
+			})
+		require.EqualError(t, err, `servers did not become healthy within 10ms:
+  (no health status was returned)
+  last error: 503 Service Unavailable`)
+	})
+}